@@ -0,0 +1,10 @@
+# Who changed the premium plan over the last month.
+data "administration_audit_events" "premium" {
+  resource_type = "billing_plan"
+  resource_id   = administration_billing_plan.premium.id
+  from          = timeadd(plantimestamp(), "-720h")
+}
+
+output "premium_changed_by" {
+  value = distinct(data.administration_audit_events.premium.events[*].actor)
+}
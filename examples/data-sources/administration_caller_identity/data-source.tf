@@ -0,0 +1,10 @@
+# Identity of the principal the provider credentials map to.
+data "administration_caller_identity" "current" {}
+
+output "caller" {
+  value = {
+    principal    = data.administration_caller_identity.current.principal_id
+    organization = data.administration_caller_identity.current.organization_id
+    scopes       = data.administration_caller_identity.current.scopes
+  }
+}
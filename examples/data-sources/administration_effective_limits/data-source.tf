@@ -0,0 +1,4 @@
+# Read the limits of an organization once overrides are applied.
+data "administration_effective_limits" "acme" {
+  organization_id = "acme"
+}
@@ -0,0 +1,15 @@
+# Paid invoices of an organization issued in 2024.
+data "administration_invoices" "acme_2024" {
+  organization_id = "acme"
+  status          = "paid"
+  from            = "2024-01-01T00:00:00Z"
+  to              = "2025-01-01T00:00:00Z"
+}
+
+# Amount invoiced per plan.
+output "acme_2024_by_plan" {
+  value = {
+    for line in flatten(data.administration_invoices.acme_2024.invoices[*].lines) :
+    line.plan_id => line.amount... if line.plan_id != null
+  }
+}
@@ -0,0 +1,12 @@
+# Three premium subscriptions for two years, with the launch coupon.
+data "administration_price_quote" "acme" {
+  plan_id            = administration_billing_plan.premium.id
+  subscribe_for_year = 2
+  currency           = "EUR"
+  quantity           = 3
+  coupon_id          = administration_coupon.launch.id
+}
+
+output "acme_total_contract_value" {
+  value = data.administration_price_quote.acme.total_contract_value
+}
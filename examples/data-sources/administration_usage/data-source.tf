@@ -0,0 +1,9 @@
+# Read the usage of an organization over the current billing period.
+data "administration_usage" "acme" {
+  organization_id = "acme"
+}
+
+# Limits the organization is close to exhausting.
+output "acme_limits_over_80_percent" {
+  value = [for u in data.administration_usage.acme.usage : u.name if coalesce(u.peak_percentage, 0) > 80]
+}
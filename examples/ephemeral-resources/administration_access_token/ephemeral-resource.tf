@@ -0,0 +1,10 @@
+# Bearer token for tools that call the Administration API directly, never
+# written to the plan or state.
+ephemeral "administration_access_token" "current" {}
+
+provider "restapi" {
+  uri = "https://api.quortex.io"
+  headers = {
+    Authorization = "Bearer ${ephemeral.administration_access_token.current.access_token}"
+  }
+}
@@ -0,0 +1,9 @@
+# Cheapest pricing of the premium plan, all its pricing items share one currency.
+output "premium_cheapest_term" {
+  value = provider::administration::cheapest_term(administration_billing_plan.premium.pricing)
+}
+
+# Cheapest EUR pricing of the premium plan.
+output "premium_cheapest_eur_term" {
+  value = provider::administration::cheapest_term(administration_billing_plan.premium.pricing, "EUR")
+}
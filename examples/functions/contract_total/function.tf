@@ -0,0 +1,4 @@
+# Total value of the first pricing of the premium plan.
+output "premium_contract_total" {
+  value = provider::administration::contract_total(administration_billing_plan.premium.pricing[0])
+}
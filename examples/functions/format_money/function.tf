@@ -0,0 +1,4 @@
+# Prints "1,234.50 EUR".
+output "formatted" {
+  value = provider::administration::format_money(1234.5, "EUR")
+}
@@ -0,0 +1,12 @@
+# Changes between the current and the next version of the premium plan.
+output "premium_changes" {
+  value = provider::administration::plan_diff(administration_billing_plan.premium, administration_billing_plan.premium_next)
+}
+
+# Fail when the next version of the premium plan removes features.
+check "premium_features_kept" {
+  assert {
+    condition     = length(provider::administration::plan_diff(administration_billing_plan.premium, administration_billing_plan.premium_next).features_removed) == 0
+    error_message = "The next premium plan must keep every feature of the current one."
+  }
+}
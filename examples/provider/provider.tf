@@ -5,3 +5,31 @@ provider "administration" {
   host          = "my_host"
   auth_server   = "my_auth_server"
 }
+
+# Preset-based host and auth server
+provider "administration" {
+  alias         = "dev"
+  environment   = "dev"
+  client_id     = "my_client_id"
+  client_secret = "my_client_secret"
+}
+
+# Profile-based authentication, reading ~/.config/quortex/credentials:
+#
+#   [staging]
+#   host          = https://api.staging.example.com
+#   auth_server   = https://auth.staging.example.com
+#   client_id     = my_client_id
+#   client_secret = my_client_secret
+provider "administration" {
+  alias   = "staging"
+  profile = "staging"
+}
+
+# Private key JWT authentication, without client secret
+provider "administration" {
+  alias            = "keyless"
+  client_id        = "my_client_id"
+  private_key_file = "/path/to/private_key.pem"
+  private_key_id   = "my_key_id"
+}
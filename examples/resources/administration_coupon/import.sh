@@ -0,0 +1,2 @@
+# Coupon can be imported by specifying the numeric identifier.
+terraform import administration_coupon.launch 123
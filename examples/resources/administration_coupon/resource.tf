@@ -0,0 +1,26 @@
+# 20% off the premium plan for the first three months.
+resource "administration_coupon" "launch" {
+  code               = "LAUNCH20"
+  percent_off        = 20
+  duration           = "repeating"
+  duration_in_months = 3
+  max_redemptions    = 100
+  plan_ids           = [administration_billing_plan.premium.id]
+  valid_until        = "2025-01-01T00:00:00Z"
+}
+
+# Fixed discount per currency, applied once.
+resource "administration_coupon" "welcome" {
+  code     = "WELCOME"
+  duration = "once"
+  amount_off = [
+    {
+      amount   = 50
+      currency = "EUR"
+    },
+    {
+      amount   = 55
+      currency = "USD"
+    },
+  ]
+}
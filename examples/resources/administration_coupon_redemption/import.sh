@@ -0,0 +1,2 @@
+# Coupon redemption can be imported by specifying the numeric identifier.
+terraform import administration_coupon_redemption.acme_launch 123
@@ -0,0 +1,5 @@
+# Redeem a coupon for an organization.
+resource "administration_coupon_redemption" "acme_launch" {
+  coupon_id       = administration_coupon.launch.id
+  organization_id = "acme"
+}
@@ -0,0 +1,2 @@
+# Feature can be imported by specifying its key.
+terraform import administration_feature.drm_widevine drm_widevine
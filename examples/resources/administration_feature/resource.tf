@@ -0,0 +1,7 @@
+# Declare a feature plans can reference.
+resource "administration_feature" "drm_widevine" {
+  key          = "drm_widevine"
+  display_name = "Widevine DRM"
+  description  = "Protect streams with Google Widevine."
+  category     = "drm"
+}
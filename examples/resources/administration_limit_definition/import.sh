@@ -0,0 +1,2 @@
+# Limit definition can be imported by specifying its key.
+terraform import administration_limit_definition.channels channels
@@ -0,0 +1,9 @@
+# Describe the channels limit plans can set.
+resource "administration_limit_definition" "channels" {
+  key               = "channels"
+  unit              = "channels"
+  min               = 1
+  max               = 500
+  unlimited_allowed = true
+  description       = "Number of live channels."
+}
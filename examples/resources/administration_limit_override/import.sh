@@ -0,0 +1,2 @@
+# Limit override can be imported by specifying the numeric identifier.
+terraform import administration_limit_override.acme_channels 123
@@ -0,0 +1,7 @@
+# Grant one organization more channels than its plan allows.
+resource "administration_limit_override" "acme_channels" {
+  organization_id = "acme"
+  name            = "channels"
+  value           = 20
+  expires_at      = "2025-01-01T00:00:00Z"
+}
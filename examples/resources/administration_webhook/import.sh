@@ -0,0 +1,3 @@
+# Webhook can be imported by specifying the numeric identifier. The signing
+# secret is not returned by the API and stays empty until the next rotation.
+terraform import administration_webhook.billing 123
@@ -0,0 +1,16 @@
+# Push billing events to an internal endpoint.
+resource "administration_webhook" "billing" {
+  url         = "https://hooks.example.com/quortex"
+  event_types = ["subscription.created", "subscription.updated", "invoice.paid"]
+  headers = {
+    "X-Team" = "finance"
+  }
+
+  # Change this value to rotate the signing secret.
+  secret_rotation = "2024-06"
+}
+
+output "billing_webhook_secret" {
+  value     = administration_webhook.billing.signing_secret
+  sensitive = true
+}
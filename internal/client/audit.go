@@ -0,0 +1,68 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AuditEventPageSize - Number of audit events requested per page.
+const AuditEventPageSize int = 100
+
+// GetAuditEventPage - Returns a page of the audit events matching filter, starting at 1.
+func (c *Client) GetAuditEventPage(filter AuditEventFilter, page int) (*AuditEventPage, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(AuditEventPageSize))
+	if filter.ResourceType != "" {
+		query.Set("resource_type", filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		query.Set("resource_id", filter.ResourceID)
+	}
+	if filter.Actor != "" {
+		query.Set("actor", filter.Actor)
+	}
+	if filter.From != "" {
+		query.Set("from", filter.From)
+	}
+	if filter.To != "" {
+		query.Set("to", filter.To)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/audit/events?%s", c.HostURL, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPage := AuditEventPage{}
+	err = json.Unmarshal(body, &eventPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventPage, nil
+}
+
+// GetAuditEvents - Returns every audit event matching filter, following pagination.
+func (c *Client) GetAuditEvents(filter AuditEventFilter) ([]AuditEvent, error) {
+	events := []AuditEvent{}
+	for page := 1; ; page++ {
+		eventPage, err := c.GetAuditEventPage(filter, page)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, eventPage.Results...)
+		if eventPage.Next == "" || len(eventPage.Results) == 0 {
+			return events, nil
+		}
+	}
+}
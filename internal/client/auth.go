@@ -3,27 +3,37 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
+)
+
+// Token endpoint authentication methods.
+const (
+	TokenEndpointAuthJSON              string = "json"
+	TokenEndpointAuthClientSecretPost  string = "client_secret_post"
+	TokenEndpointAuthClientSecretBasic string = "client_secret_basic"
+	TokenEndpointAuthPrivateKeyJWT     string = "private_key_jwt"
 )
 
 // SignIn - Get a new token for user.
 func (c *Client) SignIn() (*AuthResponse, error) {
-	if c.Auth.ClientId == "" || c.Auth.ClientSecret == "" {
+	if c.TokenEndpointAuthMethod == TokenEndpointAuthPrivateKeyJWT {
+		if c.Auth.ClientId == "" || c.PrivateKey == nil {
+			return nil, fmt.Errorf("define client_id and private_key")
+		}
+	} else if c.Auth.ClientId == "" || c.Auth.ClientSecret == "" {
 		return nil, fmt.Errorf("define client_id and client_secret")
 	}
-	rb, err := json.Marshal(c.Auth)
-	if err != nil {
-		return nil, err
-	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", c.AuthServerURL), strings.NewReader(string(rb)))
+	req, err := c.tokenRequest(c.tokenURL())
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("Content-Type", "application/json")
 
-	body, err := c.doRequest(req)
+	_, body, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -35,3 +45,176 @@ func (c *Client) SignIn() (*AuthResponse, error) {
 	}
 	return &ar, nil
 }
+
+// tokenURL returns the token endpoint of the client.
+func (c *Client) tokenURL() string {
+	if c.TokenURL != "" {
+		return c.TokenURL
+	}
+	return fmt.Sprintf("%s/oauth/token", c.AuthServerURL)
+}
+
+// Token - Returns the access token of the client, signing in when there is
+// none yet or it is about to expire.
+func (c *Client) Token() (*CachedToken, error) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	if c.session.token == nil || time.Until(c.session.token.ExpiresAt) < TokenCacheMargin {
+		if err := c.authenticate(); err != nil {
+			return nil, err
+		}
+
+		// Least privilege tokens may be granted fewer scopes than requested
+		c.session.missingScopes = missingScopes(c.Auth.Scope, c.session.token.Scope)
+		if len(c.session.missingScopes) > 0 {
+			log.Printf("[WARN] The access token was granted the scopes %q, without the requested scopes %q", c.session.token.Scope, strings.Join(c.session.missingScopes, " "))
+		}
+	}
+
+	token := *c.session.token
+	return &token, nil
+}
+
+// UnreportedMissingScopes - Returns the scopes granted to the access token and
+// the requested scopes it was not granted, the first time it is called after
+// scopes went missing so that they are reported once.
+func (c *Client) UnreportedMissingScopes() (string, []string) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	if c.session.token == nil || c.session.scopesReported || len(c.session.missingScopes) == 0 {
+		return "", nil
+	}
+	c.session.scopesReported = true
+	return c.session.token.Scope, c.session.missingScopes
+}
+
+// invalidateToken drops the access token of the session and its token cache
+// entry, unless it was already replaced by another request.
+func (c *Client) invalidateToken(rejected string) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	if c.session.token == nil || c.session.token.AccessToken != rejected {
+		return
+	}
+	c.session.token = nil
+
+	if c.TokenCache != nil {
+		if err := c.TokenCache.Remove(c); err != nil {
+			log.Printf("[WARN] Could not remove cached token from %s: %s", c.TokenCache.Dir, err)
+		}
+	}
+}
+
+// authenticate sets the access token of the session, from the token cache
+// when it holds a valid one.
+func (c *Client) authenticate() error {
+	if c.TokenCache != nil {
+		token, err := c.TokenCache.Load(c)
+		if err == nil {
+			c.session.token = token
+			return nil
+		}
+		log.Printf("[DEBUG] No cached token in %s: %s", c.TokenCache.Dir, err)
+	}
+
+	ar, err := c.SignIn()
+	if err != nil {
+		return err
+	}
+
+	token := CachedToken{
+		AccessToken: ar.AccessToken,
+		Scope:       ar.Scope,
+		ExpiresAt:   time.Now().Add(time.Duration(ar.ExpiresIn) * time.Second),
+	}
+	if token.Scope == "" {
+		// The requested scopes were granted as is
+		token.Scope = c.Auth.Scope
+	}
+	c.session.token = &token
+
+	if c.TokenCache != nil {
+		if err := c.TokenCache.Store(c, token); err != nil {
+			log.Printf("[WARN] Could not cache token in %s: %s", c.TokenCache.Dir, err)
+		}
+	}
+	return nil
+}
+
+// tokenRequest returns the client credentials request for the token
+// endpoint authentication method of the client.
+func (c *Client) tokenRequest(tokenURL string) (*http.Request, error) {
+	switch c.TokenEndpointAuthMethod {
+	case "", TokenEndpointAuthJSON:
+		rb, err := json.Marshal(c.Auth)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequest("POST", tokenURL, strings.NewReader(string(rb)))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Content-Type", "application/json")
+		return req, nil
+
+	case TokenEndpointAuthClientSecretPost, TokenEndpointAuthClientSecretBasic, TokenEndpointAuthPrivateKeyJWT:
+		form := url.Values{}
+		form.Set("grant_type", c.Auth.GrantType)
+		if c.Auth.Scope != "" {
+			form.Set("scope", c.Auth.Scope)
+		}
+		if c.Auth.Audience != "" {
+			form.Set("audience", c.Auth.Audience)
+		}
+		if c.TokenEndpointAuthMethod == TokenEndpointAuthClientSecretPost {
+			form.Set("client_id", c.Auth.ClientId)
+			form.Set("client_secret", c.Auth.ClientSecret)
+		}
+		if c.TokenEndpointAuthMethod == TokenEndpointAuthPrivateKeyJWT {
+			if c.PrivateKey == nil {
+				return nil, fmt.Errorf("token endpoint auth method %s requires a private key", TokenEndpointAuthPrivateKeyJWT)
+			}
+			// A new assertion is signed for every request, see RFC 7523 section 2.2
+			assertion, err := c.PrivateKey.assertion(c.Auth.ClientId, tokenURL)
+			if err != nil {
+				return nil, err
+			}
+			form.Set("client_id", c.Auth.ClientId)
+			form.Set("client_assertion_type", ClientAssertionType)
+			form.Set("client_assertion", assertion)
+		}
+
+		req, err := http.NewRequest("POST", tokenURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		if c.TokenEndpointAuthMethod == TokenEndpointAuthClientSecretBasic {
+			// Credentials are form encoded before being used as user and password, see RFC 6749 section 2.3.1
+			req.SetBasicAuth(url.QueryEscape(c.Auth.ClientId), url.QueryEscape(c.Auth.ClientSecret))
+		}
+		return req, nil
+	}
+
+	return nil, fmt.Errorf("unsupported token endpoint auth method %q", c.TokenEndpointAuthMethod)
+}
+
+// missingScopes returns the requested scopes that were not granted.
+func missingScopes(requested, granted string) []string {
+	grantedScopes := map[string]bool{}
+	for _, scope := range strings.Fields(granted) {
+		grantedScopes[scope] = true
+	}
+
+	var missing []string
+	for _, scope := range strings.Fields(requested) {
+		if !grantedScopes[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestTokenEndpointAuthMethods(t *testing.T) {
+	clientID, clientSecret := "client:id", "s3cr+t/é ok"
+
+	tests := []struct {
+		method    string
+		wantForm  url.Values
+		wantBasic string
+	}{
+		{
+			method: TokenEndpointAuthClientSecretPost,
+			wantForm: url.Values{
+				"grant_type":    {"client_credentials"},
+				"scope":         {"plans:read plans:write"},
+				"client_id":     {clientID},
+				"client_secret": {clientSecret},
+			},
+		},
+		{
+			method: TokenEndpointAuthClientSecretBasic,
+			wantForm: url.Values{
+				"grant_type": {"client_credentials"},
+				"scope":      {"plans:read plans:write"},
+			},
+			// Form encoded before being joined, see RFC 6749 section 2.3.1
+			wantBasic: "Basic " + base64.StdEncoding.EncodeToString([]byte("client%3Aid:s3cr%2Bt%2F%C3%A9+ok")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+					t.Errorf("Content-Type = %q, want a form", got)
+				}
+				if got := r.Header.Get("Authorization"); got != tt.wantBasic {
+					t.Errorf("Authorization = %q, want %q", got, tt.wantBasic)
+				}
+				if err := r.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				if got := r.PostForm.Encode(); got != tt.wantForm.Encode() {
+					t.Errorf("form = %s, want %s", got, tt.wantForm.Encode())
+				}
+				w.Write([]byte(`{"access_token":"token","expires_in":3600,"token_type":"Bearer"}`))
+			}))
+			defer s.Close()
+
+			c, err := NewClient(&s.URL, &s.URL, &clientID, &clientSecret,
+				WithTokenEndpointAuthMethod(tt.method),
+				WithScopes([]string{"plans:read", "plans:write"}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ar, err := c.SignIn()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ar.AccessToken != "token" {
+				t.Errorf("SignIn() = %q, want token", ar.AccessToken)
+			}
+		})
+	}
+}
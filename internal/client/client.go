@@ -1,13 +1,21 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// OrganizationHeader - Header scoping requests to an organization.
+const OrganizationHeader string = "X-Organization-ID"
+
 // HostURL - Default Administration URL.
 const AuthServerURL string = "https://auth.quortex.io"
 const HostURL string = "https://api.quortex.io"
@@ -16,14 +24,45 @@ type Client struct {
 	AuthServerURL string
 	HostURL       string
 	HTTPClient    *http.Client
-	Token         string
 	Auth          AuthStruct
+	UserAgent     string
+	// TokenURL - Token endpoint, derived from AuthServerURL when empty.
+	TokenURL string
+	// TokenEndpointAuthMethod - How client credentials are sent to the token endpoint, JSON when empty.
+	TokenEndpointAuthMethod string
+	// PrivateKey - Key signing client assertions, used with private_key_jwt.
+	PrivateKey *PrivateKey
+	// OrganizationID - Organization the client acts on behalf of, if set.
+	OrganizationID string
+	// ReadOnly - Refuse any request to the Administration API that is not a GET.
+	ReadOnly bool
+	// Journal - Record every mutating request to the Administration API, if set.
+	Journal *Journal
+	// RateLimiter - Limit the rate and concurrency of requests, if set.
+	RateLimiter *RateLimiter
+	// TokenCache - Share access tokens with other processes, if set.
+	TokenCache *TokenCache
+
+	session *session
+}
+
+// session - Access token shared by a client and its copies, obtained on first
+// request.
+type session struct {
+	mu    sync.Mutex
+	token *CachedToken
+	// missingScopes - Requested scopes the token was not granted.
+	missingScopes []string
+	// scopesReported - Whether missingScopes were returned by UnreportedMissingScopes.
+	scopesReported bool
 }
 
 type AuthStruct struct {
 	ClientId     string `json:"client_id"`
 	ClientSecret string `json:"client_secret"`
 	GrantType    string `json:"grant_type"`
+	Scope        string `json:"scope,omitempty"`
+	Audience     string `json:"audience,omitempty"`
 }
 
 type AuthResponse struct {
@@ -33,9 +72,24 @@ type AuthResponse struct {
 	TokenType   string `json:"token_type"`
 }
 
-func NewClient(auth_server, host, client_id, client_secret *string) (*Client, error) {
+// ErrReadOnly - Error returned when a mutating request is sent by a read only client.
+var ErrReadOnly = errors.New("client is read only")
+
+// StatusError - Error returned when the API answers with an unexpected status.
+type StatusError struct {
+	StatusCode int
+	RequestID  string
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status: %d, request id: %s, body: %s", e.StatusCode, e.RequestID, e.Body)
+}
+
+func NewClient(auth_server, host, client_id, client_secret *string, options ...Option) (*Client, error) {
 	c := Client{
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		session:    &session{},
 		// Default Administration URL
 		HostURL:       HostURL,
 		AuthServerURL: AuthServerURL,
@@ -54,38 +108,112 @@ func NewClient(auth_server, host, client_id, client_secret *string) (*Client, er
 		c.HostURL = *host
 	}
 
-	ar, err := c.SignIn()
-	if err != nil {
-		return nil, err
+	for _, option := range options {
+		option(&c)
 	}
 
-	c.Token = "Bearer " + ar.AccessToken
-
 	return &c, nil
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	if c.Token != "" {
-		req.Header.Set("Authorization", c.Token)
+	return c.do(req, req.Method != http.MethodGet)
+}
+
+// doReadRequest sends a request that changes nothing whatever its method, such
+// as a POST computing a quote. It is allowed in read only mode and not
+// journaled.
+func (c *Client) doReadRequest(req *http.Request) ([]byte, error) {
+	return c.do(req, false)
+}
+
+// do authenticates and sends the request, mutating requests are refused in
+// read only mode and journaled.
+func (c *Client) do(req *http.Request, mutating bool) ([]byte, error) {
+	if c.ReadOnly && mutating {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+	}
+
+	body, token, err := c.doAuthenticated(req, mutating, true)
+	var statusErr *StatusError
+	if token != nil && errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusUnauthorized {
+		// The token may have been revoked or its grants changed before it
+		// expired, sign in again and retry once
+		log.Printf("[DEBUG] Access token rejected by %s %s, signing in again", req.Method, req.URL.Path)
+		c.invalidateToken(token.AccessToken)
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+		body, _, err = c.doAuthenticated(req, mutating, false)
+	}
+	return body, err
+}
+
+// doAuthenticated sends the request with the access token of the client,
+// which is returned along with the response body. Mutations rejected as
+// unauthorized are not journaled when retryable.
+func (c *Client) doAuthenticated(req *http.Request, mutating, retryable bool) ([]byte, *CachedToken, error) {
+	// Sign in on first request
+	token, err := c.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	if c.Journal != nil && mutating {
+		body, err := c.doJournaledRequest(req, retryable)
+		return body, token, err
+	}
+
+	_, body, err := c.send(req)
+	return body, token, err
+}
+
+// send sends the request, the response is returned whenever the API answered.
+func (c *Client) send(req *http.Request) (*http.Response, []byte, error) {
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if c.OrganizationID != "" && strings.HasPrefix(req.URL.String(), c.HostURL) {
+		req.Header.Set(OrganizationHeader, c.OrganizationID)
+	}
+
+	// Identify the request in the API logs and in errors
+	requestID := req.Header.Get("X-Request-ID")
+	if requestID == "" {
+		requestID = uuid.NewString()
+		req.Header.Set("X-Request-ID", requestID)
+	}
+
+	if c.RateLimiter != nil {
+		wait := c.RateLimiter.Acquire()
+		defer c.RateLimiter.Release()
+		if wait > 0 {
+			log.Printf("[DEBUG] Waited %s for rate limiter before %s %s", wait, req.Method, req.URL.Path)
+		}
 	}
-	req.Header.Set("Content-Type", "application/json")
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("request id: %s: %w", requestID, err)
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return res, nil, fmt.Errorf("request id: %s: %w", requestID, err)
 	}
 
 	log.Println(res)
 	log.Println(res.StatusCode)
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusNoContent {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return res, nil, &StatusError{StatusCode: res.StatusCode, RequestID: requestID, Body: body}
 	}
 
-	return body, err
+	return res, body, err
 }
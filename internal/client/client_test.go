@@ -0,0 +1,240 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testServer is an auth server and Administration API, issuing numbered
+// tokens.
+type testServer struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// tokens - Number of tokens issued, the last one is token-<tokens>.
+	tokens  int
+	handler func(w http.ResponseWriter, r *http.Request)
+}
+
+func newTestServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *testServer {
+	t.Helper()
+	s := &testServer{handler: handler}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		if r.URL.Path == "/oauth/token" {
+			s.tokens++
+			fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":3600,"token_type":"Bearer"}`, s.tokens)
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+
+		s.handler(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// newTestClient returns a client of the test server.
+func newTestClient(t *testing.T, s *testServer, options ...Option) *Client {
+	t.Helper()
+	clientID, clientSecret := "client", "secret"
+	c, err := NewClient(&s.URL, &s.URL, &clientID, &clientSecret, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCreateQuoteIsNotAMutation(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"currency":"EUR","monthly_price":10}`))
+	})
+	journalPath := filepath.Join(t.TempDir(), "journal.jsonl")
+	c := newTestClient(t, s, WithReadOnly(true), WithJournal(NewJournal(journalPath)))
+
+	quote, err := c.CreateQuote(QuoteRequest{PlanID: 1, SubscribeForYear: 1, Currency: "EUR", Quantity: 1})
+	if err != nil {
+		t.Fatalf("CreateQuote() in read only mode: %s", err)
+	}
+	if quote.MonthlyPrice != 10 {
+		t.Errorf("CreateQuote() monthly price = %v, want 10", quote.MonthlyPrice)
+	}
+
+	if _, err := os.Stat(journalPath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("CreateQuote() wrote to the journal: %v", err)
+	}
+}
+
+func TestMutationsAreRefusedInReadOnlyMode(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	c := newTestClient(t, s, WithReadOnly(true))
+
+	_, err := c.CreatePlan(Plan{Name: "premium"})
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("CreatePlan() in read only mode = %v, want %v", err, ErrReadOnly)
+	}
+}
+
+func TestRequestsAreIdentified(t *testing.T) {
+	userAgent := "terraform-provider-administration/1.2.0 terraform/1.10.0"
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != userAgent {
+			t.Errorf("User-Agent = %q, want %q", got, userAgent)
+		}
+		if r.Header.Get("X-Request-ID") == "" {
+			t.Error("X-Request-ID is missing")
+		}
+		w.Write([]byte(`{"id":42,"name":"premium"}`))
+	})
+	c := newTestClient(t, s, WithUserAgent(userAgent))
+
+	if _, err := c.GetPlan("42"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMutationsAreJournaled(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":42,"name":"premium"}`))
+	})
+	journalPath := filepath.Join(t.TempDir(), "journal.jsonl")
+	c := newTestClient(t, s, WithJournal(NewJournal(journalPath)))
+
+	if _, err := c.CreatePlan(Plan{Name: "premium"}); err != nil {
+		t.Fatal(err)
+	}
+
+	journal, err := os.ReadFile(journalPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(journal), "\n"); lines != 1 {
+		t.Errorf("journal has %d entries, want 1:\n%s", lines, journal)
+	}
+}
+
+func TestRejectedTokenIsRenewed(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if body, _ := io.ReadAll(r.Body); string(body) == "" {
+			t.Errorf("retried request has no body")
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":42,"name":"premium"}`))
+	})
+	tokenCache := &TokenCache{Dir: t.TempDir()}
+	c := newTestClient(t, s, WithTokenCache(tokenCache))
+
+	// Revoked token still cached by an earlier provider process
+	stale := CachedToken{AccessToken: "revoked", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := tokenCache.Store(c, stale); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreatePlan(Plan{Name: "premium"}); err != nil {
+		t.Fatalf("CreatePlan() with a revoked token: %s", err)
+	}
+	if s.tokens != 1 {
+		t.Errorf("signed in %d times, want 1", s.tokens)
+	}
+
+	cached, err := tokenCache.Load(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.AccessToken != "token-1" {
+		t.Errorf("cached token = %s, want token-1", cached.AccessToken)
+	}
+}
+
+func TestRetriedMutationIsJournaledOnce(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":42,"name":"premium"}`))
+	})
+	tokenCache := &TokenCache{Dir: t.TempDir()}
+	journalPath := filepath.Join(t.TempDir(), "journal.jsonl")
+	c := newTestClient(t, s, WithTokenCache(tokenCache), WithJournal(NewJournal(journalPath)))
+
+	stale := CachedToken{AccessToken: "revoked", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := tokenCache.Store(c, stale); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreatePlan(Plan{Name: "premium"}); err != nil {
+		t.Fatal(err)
+	}
+
+	journal, err := os.ReadFile(journalPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(journal), "\n"); lines != 1 {
+		t.Fatalf("journal has %d entries, want 1:\n%s", lines, journal)
+	}
+	if !strings.Contains(string(journal), `"status":201`) {
+		t.Errorf("journal entry isn't the retried request:\n%s", journal)
+	}
+}
+
+func TestRejectedTokenIsRetriedOnce(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	c := newTestClient(t, s)
+
+	_, err := c.GetPlan("42")
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("GetPlan() = %v, want a %d status error", err, http.StatusUnauthorized)
+	}
+	if s.tokens != 2 {
+		t.Errorf("signed in %d times, want 2", s.tokens)
+	}
+}
+
+func TestUnreportedMissingScopes(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"token","scope":"plans:read","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer s.Close()
+	clientID, clientSecret := "client", "secret"
+	c, err := NewClient(&s.URL, &s.URL, &clientID, &clientSecret, WithScopes([]string{"plans:read", "plans:write"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Token(); err != nil {
+		t.Fatal(err)
+	}
+
+	granted, missing := c.UnreportedMissingScopes()
+	if granted != "plans:read" || strings.Join(missing, " ") != "plans:write" {
+		t.Errorf("UnreportedMissingScopes() = %q, %q, want %q, %q", granted, missing, "plans:read", "plans:write")
+	}
+
+	// Copies share the session, scopes are reported once
+	if _, missing := c.ForOrganization("org").UnreportedMissingScopes(); missing != nil {
+		t.Errorf("UnreportedMissingScopes() called again = %q, want none", missing)
+	}
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetCoupon - Returns a specific coupon.
+func (c *Client) GetCoupon(couponID string) (*Coupon, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/coupons/%s", c.HostURL, couponID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	coupon := Coupon{}
+	err = json.Unmarshal(body, &coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coupon, nil
+}
+
+// CreateCoupon - Create new coupon.
+func (c *Client) CreateCoupon(coupon Coupon) (*Coupon, error) {
+	rb, err := json.Marshal(coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/billing/coupons", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rcoupon := Coupon{}
+	err = json.Unmarshal(body, &rcoupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rcoupon, nil
+}
+
+// UpdateCoupon - Updates a coupon.
+func (c *Client) UpdateCoupon(couponID string, coupon Coupon) (*Coupon, error) {
+	rb, err := json.Marshal(coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/1.0/manage/billing/coupons/%s", c.HostURL, couponID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rcoupon := Coupon{}
+	err = json.Unmarshal(body, &rcoupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rcoupon, nil
+}
+
+// DeleteCoupon - Deletes a coupon.
+func (c *Client) DeleteCoupon(couponID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/1.0/manage/billing/coupons/%s", c.HostURL, couponID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCouponRedemption - Returns a specific coupon redemption.
+func (c *Client) GetCouponRedemption(redemptionID string) (*CouponRedemption, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/coupon_redemptions/%s", c.HostURL, redemptionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	redemption := CouponRedemption{}
+	err = json.Unmarshal(body, &redemption)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redemption, nil
+}
+
+// CreateCouponRedemption - Redeems a coupon for an organization.
+func (c *Client) CreateCouponRedemption(redemption CouponRedemption) (*CouponRedemption, error) {
+	rb, err := json.Marshal(redemption)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/billing/coupon_redemptions", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rredemption := CouponRedemption{}
+	err = json.Unmarshal(body, &rredemption)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rredemption, nil
+}
+
+// DeleteCouponRedemption - Deletes a coupon redemption.
+func (c *Client) DeleteCouponRedemption(redemptionID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/1.0/manage/billing/coupon_redemptions/%s", c.HostURL, redemptionID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+// EnvironmentCustom - Environment without preset, host and auth server must be set.
+const EnvironmentCustom string = "custom"
+
+// Environment - Administration API and auth server of a deployment.
+type Environment struct {
+	HostURL       string
+	AuthServerURL string
+}
+
+// Environments - Known deployments by name.
+var Environments = map[string]Environment{
+	"production": {
+		HostURL:       HostURL,
+		AuthServerURL: AuthServerURL,
+	},
+	"staging": {
+		HostURL:       "https://api.staging.quortex.io",
+		AuthServerURL: "https://auth.staging.quortex.io",
+	},
+	"dev": {
+		HostURL:       "https://api.dev.quortex.io",
+		AuthServerURL: "https://auth.dev.quortex.io",
+	},
+}
+
+// GuessEnvironment - Returns the name of the environment a URL looks like it
+// belongs to, or an empty string when it can't tell.
+func GuessEnvironment(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	hostname := strings.ToLower(u.Hostname())
+
+	for name, environment := range Environments {
+		for _, known := range []string{environment.HostURL, environment.AuthServerURL} {
+			if k, err := url.Parse(known); err == nil && k.Hostname() == hostname {
+				return name
+			}
+		}
+	}
+
+	for _, label := range strings.FieldsFunc(hostname, func(r rune) bool { return r == '.' || r == '-' }) {
+		switch label {
+		case "staging", "stg":
+			return "staging"
+		case "dev":
+			return "dev"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetFeatures - Returns the feature catalog.
+func (c *Client) GetFeatures() ([]Feature, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/features", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []Feature{}
+	err = json.Unmarshal(body, &features)
+	if err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
+
+// GetFeature - Returns a specific feature.
+func (c *Client) GetFeature(key string) (*Feature, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/features/%s", c.HostURL, key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	feature := Feature{}
+	err = json.Unmarshal(body, &feature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feature, nil
+}
+
+// CreateFeature - Create new feature.
+func (c *Client) CreateFeature(feature Feature) (*Feature, error) {
+	rb, err := json.Marshal(feature)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/billing/features", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rfeature := Feature{}
+	err = json.Unmarshal(body, &rfeature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rfeature, nil
+}
+
+// UpdateFeature - Updates a feature.
+func (c *Client) UpdateFeature(key string, feature Feature) (*Feature, error) {
+	rb, err := json.Marshal(feature)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/1.0/manage/billing/features/%s", c.HostURL, key), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rfeature := Feature{}
+	err = json.Unmarshal(body, &rfeature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rfeature, nil
+}
+
+// DeleteFeature - Deletes a feature.
+func (c *Client) DeleteFeature(key string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/1.0/manage/billing/features/%s", c.HostURL, key), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
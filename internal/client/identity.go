@@ -0,0 +1,28 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetCallerIdentity - Returns the principal the configured credentials map to.
+func (c *Client) GetCallerIdentity() (*CallerIdentity, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/me", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := CallerIdentity{}
+	err = json.Unmarshal(body, &identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// InvoicePageSize - Number of invoices requested per page.
+const InvoicePageSize int = 100
+
+// GetInvoicePage - Returns a page of the invoices matching filter, starting at 1.
+func (c *Client) GetInvoicePage(filter InvoiceFilter, page int) (*InvoicePage, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(InvoicePageSize))
+	if filter.OrganizationID != "" {
+		query.Set("organization_id", filter.OrganizationID)
+	}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+	if filter.From != "" {
+		query.Set("from", filter.From)
+	}
+	if filter.To != "" {
+		query.Set("to", filter.To)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/invoices?%s", c.HostURL, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	invoicePage := InvoicePage{}
+	err = json.Unmarshal(body, &invoicePage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoicePage, nil
+}
+
+// GetInvoices - Returns every invoice matching filter, following pagination.
+func (c *Client) GetInvoices(filter InvoiceFilter) ([]Invoice, error) {
+	invoices := []Invoice{}
+	for page := 1; ; page++ {
+		invoicePage, err := c.GetInvoicePage(filter, page)
+		if err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, invoicePage.Results...)
+		if invoicePage.Next == "" || len(invoicePage.Results) == 0 {
+			return invoices, nil
+		}
+	}
+}
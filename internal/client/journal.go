@@ -0,0 +1,182 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JournalRedacted - Value replacing secrets in journal payloads.
+const JournalRedacted string = "REDACTED"
+
+// JournalEntry - Record of a mutating request.
+type JournalEntry struct {
+	Timestamp      string          `json:"timestamp"`
+	Verb           string          `json:"verb"`
+	OrganizationID string          `json:"organization_id,omitempty"`
+	ResourceType   string          `json:"resource_type"`
+	ResourceID     string          `json:"resource_id,omitempty"`
+	Before         json.RawMessage `json:"before,omitempty"`
+	After          json.RawMessage `json:"after,omitempty"`
+	Status         int             `json:"status"`
+	RequestID      string          `json:"request_id,omitempty"`
+}
+
+// Journal - Local JSON lines file recording every mutating request.
+type Journal struct {
+	Path string
+	mu   sync.Mutex
+}
+
+// NewJournal - Returns a journal appending to path, the file is created on
+// first write.
+func NewJournal(path string) *Journal {
+	return &Journal{Path: path}
+}
+
+// Record - Appends an entry to the journal, payloads are redacted. The file is
+// locked while writing so that concurrent writers don't interleave lines.
+func (j *Journal) Record(entry JournalEntry) error {
+	if entry.Timestamp == "" {
+		entry.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	entry.Before = redactPayload(entry.Before)
+	entry.After = redactPayload(entry.After)
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f, err := os.OpenFile(j.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := lockFile(f); err != nil {
+		return err
+	}
+	defer unlockFile(f)
+
+	_, err = f.Write(line)
+	return err
+}
+
+// doJournaledRequest sends a mutating request and records it in the journal,
+// along with the resource as it was before the change for updates and deletes.
+// The request isn't recorded when it is rejected as unauthorized and retryable,
+// the API didn't apply it.
+func (c *Client) doJournaledRequest(req *http.Request, retryable bool) ([]byte, error) {
+	entry := JournalEntry{Verb: req.Method, OrganizationID: c.OrganizationID}
+	entry.ResourceType = strings.TrimPrefix(req.URL.Path, "/1.0/manage/")
+	if req.Method != http.MethodPost {
+		if i := strings.LastIndex(entry.ResourceType, "/"); i >= 0 {
+			entry.ResourceType, entry.ResourceID = entry.ResourceType[:i], entry.ResourceType[i+1:]
+		}
+
+		beforeReq, err := http.NewRequest("GET", req.URL.String(), nil)
+		if err == nil {
+			beforeReq.Header.Set("Authorization", req.Header.Get("Authorization"))
+			if _, before, err := c.send(beforeReq); err == nil {
+				entry.Before = before
+			}
+		}
+	}
+
+	res, body, err := c.send(req)
+	entry.RequestID = req.Header.Get("X-Request-ID")
+	if res != nil {
+		entry.Status = res.StatusCode
+	}
+	if err == nil {
+		entry.After = body
+		if entry.ResourceID == "" {
+			entry.ResourceID = journalResourceID(body)
+		}
+	}
+
+	if retryable && res != nil && res.StatusCode == http.StatusUnauthorized {
+		return body, err
+	}
+
+	// The change may be applied at this point, failing the request would leave it out of the state
+	if jerr := c.Journal.Record(entry); jerr != nil {
+		log.Printf("[ERROR] Could not record %s %s in journal %s: %s", req.Method, req.URL.Path, c.Journal.Path, jerr)
+	}
+
+	return body, err
+}
+
+// journalResourceID returns the identifier of a created resource.
+func journalResourceID(body []byte) string {
+	var created map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&created); err != nil {
+		return ""
+	}
+	for _, key := range []string{"id", "key"} {
+		if id, ok := created[key]; ok && id != nil {
+			return fmt.Sprint(id)
+		}
+	}
+	return ""
+}
+
+// redactPayload replaces the values of secret fields of a JSON payload.
+// Payloads that are not JSON are dropped.
+func redactPayload(payload json.RawMessage) json.RawMessage {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(payload, &value); err != nil {
+		return nil
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isSecretField(key) {
+				v[key] = JournalRedacted
+			} else {
+				v[key] = redactValue(item)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// isSecretField reports whether a field holds credentials. Webhook headers
+// commonly carry authorization tokens so they are redacted as a whole.
+func isSecretField(key string) bool {
+	key = strings.ToLower(key)
+	return key == "headers" ||
+		strings.Contains(key, "secret") ||
+		strings.Contains(key, "token") ||
+		strings.Contains(key, "password")
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetLimitDefinitions - Returns the limit definition catalog.
+func (c *Client) GetLimitDefinitions() ([]LimitDefinition, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/limit_definitions", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := []LimitDefinition{}
+	err = json.Unmarshal(body, &definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+// GetLimitDefinition - Returns a specific limit definition.
+func (c *Client) GetLimitDefinition(key string) (*LimitDefinition, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/limit_definitions/%s", c.HostURL, key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := LimitDefinition{}
+	err = json.Unmarshal(body, &definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+// CreateLimitDefinition - Create new limit definition.
+func (c *Client) CreateLimitDefinition(definition LimitDefinition) (*LimitDefinition, error) {
+	rb, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/billing/limit_definitions", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rdefinition := LimitDefinition{}
+	err = json.Unmarshal(body, &rdefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdefinition, nil
+}
+
+// UpdateLimitDefinition - Updates a limit definition.
+func (c *Client) UpdateLimitDefinition(key string, definition LimitDefinition) (*LimitDefinition, error) {
+	rb, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/1.0/manage/billing/limit_definitions/%s", c.HostURL, key), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rdefinition := LimitDefinition{}
+	err = json.Unmarshal(body, &rdefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdefinition, nil
+}
+
+// DeleteLimitDefinition - Deletes a limit definition.
+func (c *Client) DeleteLimitDefinition(key string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/1.0/manage/billing/limit_definitions/%s", c.HostURL, key), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetLimitOverride - Returns a specific limit override.
+func (c *Client) GetLimitOverride(overrideID string) (*LimitOverride, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/limit_overrides/%s", c.HostURL, overrideID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	override := LimitOverride{}
+	err = json.Unmarshal(body, &override)
+	if err != nil {
+		return nil, err
+	}
+
+	return &override, nil
+}
+
+// CreateLimitOverride - Create new limit override.
+func (c *Client) CreateLimitOverride(override LimitOverride) (*LimitOverride, error) {
+	rb, err := json.Marshal(override)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/billing/limit_overrides", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	roverride := LimitOverride{}
+	err = json.Unmarshal(body, &roverride)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roverride, nil
+}
+
+// UpdateLimitOverride - Updates a limit override.
+func (c *Client) UpdateLimitOverride(overrideID string, override LimitOverride) (*LimitOverride, error) {
+	rb, err := json.Marshal(override)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/1.0/manage/billing/limit_overrides/%s", c.HostURL, overrideID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	roverride := LimitOverride{}
+	err = json.Unmarshal(body, &roverride)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roverride, nil
+}
+
+// DeleteLimitOverride - Deletes a limit override.
+func (c *Client) DeleteLimitOverride(overrideID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/1.0/manage/billing/limit_overrides/%s", c.HostURL, overrideID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetEffectiveLimits - Returns the limits of an organization once overrides are applied.
+func (c *Client) GetEffectiveLimits(organizationID string) (*EffectiveLimits, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/organizations/%s/limits", c.HostURL, organizationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := EffectiveLimits{}
+	err = json.Unmarshal(body, &limits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &limits, nil
+}
@@ -1,5 +1,7 @@
 package client
 
+import "encoding/json"
+
 type LimitsItem struct {
 	Name  string `json:"name"`
 	Value int    `json:"value"`
@@ -18,3 +20,175 @@ type Plan struct {
 	Limits   []LimitsItem   `json:"limits"`
 	Pricing  []PrincingItem `json:"pricing"`
 }
+
+type LimitOverride struct {
+	ID             int    `json:"id,omitempty"`
+	OrganizationID string `json:"organization_id"`
+	Name           string `json:"name"`
+	Value          int    `json:"value"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+}
+
+type EffectiveLimitItem struct {
+	Name       string `json:"name"`
+	Value      int    `json:"value"`
+	PlanValue  int    `json:"plan_value"`
+	Overridden bool   `json:"overridden"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+}
+
+type EffectiveLimits struct {
+	OrganizationID string               `json:"organization_id"`
+	PlanID         int                  `json:"plan_id"`
+	Limits         []EffectiveLimitItem `json:"limits"`
+}
+
+type CouponAmount struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+type Coupon struct {
+	ID               int            `json:"id,omitempty"`
+	Code             string         `json:"code"`
+	PercentOff       float64        `json:"percent_off,omitempty"`
+	AmountOff        []CouponAmount `json:"amount_off,omitempty"`
+	Duration         string         `json:"duration"`
+	DurationInMonths int            `json:"duration_in_months,omitempty"`
+	MaxRedemptions   int            `json:"max_redemptions,omitempty"`
+	PlanIDs          []int          `json:"plan_ids"`
+	ValidFrom        string         `json:"valid_from,omitempty"`
+	ValidUntil       string         `json:"valid_until,omitempty"`
+}
+
+type CouponRedemption struct {
+	ID             int    `json:"id,omitempty"`
+	CouponID       int    `json:"coupon_id"`
+	OrganizationID string `json:"organization_id"`
+	RedeemedAt     string `json:"redeemed_at,omitempty"`
+}
+
+type Feature struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description,omitempty"`
+	Category    string `json:"category,omitempty"`
+}
+
+type LimitDefinition struct {
+	Key              string `json:"key"`
+	Unit             string `json:"unit"`
+	Min              *int   `json:"min,omitempty"`
+	Max              *int   `json:"max,omitempty"`
+	UnlimitedAllowed bool   `json:"unlimited_allowed"`
+	Description      string `json:"description,omitempty"`
+}
+
+type Webhook struct {
+	ID            int               `json:"id,omitempty"`
+	URL           string            `json:"url"`
+	EventTypes    []string          `json:"event_types"`
+	Enabled       bool              `json:"enabled"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	SigningSecret string            `json:"signing_secret,omitempty"`
+}
+
+type UsageItem struct {
+	Name    string `json:"name"`
+	Current int    `json:"current"`
+	Peak    int    `json:"peak"`
+}
+
+type Usage struct {
+	OrganizationID string      `json:"organization_id"`
+	From           string      `json:"from"`
+	To             string      `json:"to"`
+	Items          []UsageItem `json:"items"`
+}
+
+type InvoiceLine struct {
+	Description      string  `json:"description"`
+	PlanID           int     `json:"plan_id,omitempty"`
+	SubscribeForYear int     `json:"subscribe_for_year,omitempty"`
+	Quantity         int     `json:"quantity"`
+	UnitPrice        float64 `json:"unit_price"`
+	Amount           float64 `json:"amount"`
+}
+
+type Invoice struct {
+	ID             int           `json:"id"`
+	Number         string        `json:"number"`
+	OrganizationID string        `json:"organization_id"`
+	PeriodStart    string        `json:"period_start"`
+	PeriodEnd      string        `json:"period_end"`
+	IssuedAt       string        `json:"issued_at"`
+	Status         string        `json:"status"`
+	Currency       string        `json:"currency"`
+	Subtotal       float64       `json:"subtotal"`
+	Tax            float64       `json:"tax"`
+	Total          float64       `json:"total"`
+	Lines          []InvoiceLine `json:"lines"`
+}
+
+type InvoiceFilter struct {
+	OrganizationID string
+	Status         string
+	From           string
+	To             string
+}
+
+type InvoicePage struct {
+	Count   int       `json:"count"`
+	Next    string    `json:"next"`
+	Results []Invoice `json:"results"`
+}
+
+type AuditEvent struct {
+	ID           string `json:"id"`
+	OccurredAt   string `json:"occurred_at"`
+	Actor        string `json:"actor"`
+	ActorType    string `json:"actor_type"`
+	Action       string `json:"action"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	RequestID    string `json:"request_id,omitempty"`
+	// Changes is the raw JSON description of the changed fields.
+	Changes json.RawMessage `json:"changes,omitempty"`
+}
+
+type AuditEventFilter struct {
+	ResourceType string
+	ResourceID   string
+	Actor        string
+	From         string
+	To           string
+}
+
+type AuditEventPage struct {
+	Count   int          `json:"count"`
+	Next    string       `json:"next"`
+	Results []AuditEvent `json:"results"`
+}
+
+type CallerIdentity struct {
+	PrincipalID    string `json:"principal_id"`
+	PrincipalType  string `json:"principal_type"`
+	OrganizationID string `json:"organization_id"`
+}
+
+type QuoteRequest struct {
+	PlanID           int    `json:"plan_id"`
+	SubscribeForYear int    `json:"subscribe_for_year"`
+	Currency         string `json:"currency"`
+	Quantity         int    `json:"quantity"`
+	CouponID         int    `json:"coupon_id,omitempty"`
+}
+
+type Quote struct {
+	Currency           string  `json:"currency"`
+	MonthlyPrice       float64 `json:"monthly_price"`
+	MonthlyDiscount    float64 `json:"monthly_discount"`
+	DiscountedMonths   int     `json:"discounted_months"`
+	AnnualValue        float64 `json:"annual_value"`
+	TotalContractValue float64 `json:"total_contract_value"`
+}
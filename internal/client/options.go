@@ -0,0 +1,100 @@
+package client
+
+import "strings"
+
+// Option - Setting applied to the client before it signs in.
+type Option func(*Client)
+
+// WithUserAgent - Sends userAgent as the User-Agent of every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithReadOnly - Refuses any request to the Administration API that is not a GET.
+func WithReadOnly(readOnly bool) Option {
+	return func(c *Client) {
+		c.ReadOnly = readOnly
+	}
+}
+
+// WithJournal - Records every mutating request to the Administration API in journal.
+func WithJournal(journal *Journal) Option {
+	return func(c *Client) {
+		c.Journal = journal
+	}
+}
+
+// WithRateLimiter - Limits the rate and concurrency of requests, sign in included.
+func WithRateLimiter(rateLimiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.RateLimiter = rateLimiter
+	}
+}
+
+// WithOrganizationID - Acts on behalf of organizationID on every request to
+// the Administration API.
+func WithOrganizationID(organizationID string) Option {
+	return func(c *Client) {
+		c.OrganizationID = organizationID
+	}
+}
+
+// ForOrganization - Returns a copy of the client acting on behalf of
+// organizationID, or the client itself when organizationID is empty.
+func (c *Client) ForOrganization(organizationID string) *Client {
+	if organizationID == "" || organizationID == c.OrganizationID {
+		return c
+	}
+
+	scoped := *c
+	scoped.OrganizationID = organizationID
+	return &scoped
+}
+
+// WithScopes - Requests tokens restricted to scopes.
+func WithScopes(scopes []string) Option {
+	return func(c *Client) {
+		c.Auth.Scope = strings.Join(scopes, " ")
+	}
+}
+
+// WithAudience - Requests tokens for audience.
+func WithAudience(audience string) Option {
+	return func(c *Client) {
+		c.Auth.Audience = audience
+	}
+}
+
+// WithTokenURL - Requests tokens from tokenURL instead of the token endpoint
+// of the auth server.
+func WithTokenURL(tokenURL string) Option {
+	return func(c *Client) {
+		c.TokenURL = tokenURL
+	}
+}
+
+// WithTokenEndpointAuthMethod - Sends client credentials to the token
+// endpoint with method.
+func WithTokenEndpointAuthMethod(method string) Option {
+	return func(c *Client) {
+		c.TokenEndpointAuthMethod = method
+	}
+}
+
+// WithPrivateKey - Signs the client assertions sent with private_key_jwt
+// with privateKey.
+func WithPrivateKey(privateKey *PrivateKey) Option {
+	return func(c *Client) {
+		c.PrivateKey = privateKey
+	}
+}
+
+// WithTokenCache - Reuses access tokens from tokenCache until shortly before
+// they expire.
+func WithTokenCache(tokenCache *TokenCache) Option {
+	return func(c *Client) {
+		c.TokenCache = tokenCache
+	}
+}
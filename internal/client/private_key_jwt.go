@@ -0,0 +1,141 @@
+package client
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientAssertionType - Type of the client assertions sent with
+// private_key_jwt, see RFC 7523 section 2.2.
+const ClientAssertionType string = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// ClientAssertionLifetime - Client assertions expire this long after they are signed.
+const ClientAssertionLifetime = time.Minute
+
+// Client assertion signing algorithms.
+const (
+	SigningAlgorithmRS256 string = "RS256"
+	SigningAlgorithmES256 string = "ES256"
+)
+
+// PrivateKey - Key signing the client assertions of the client.
+type PrivateKey struct {
+	KeyID     string
+	Algorithm string
+
+	signer crypto.Signer
+	der    []byte
+}
+
+// ParsePrivateKey - Parses a PEM encoded PKCS #8, PKCS #1 or SEC 1 private
+// key. The algorithm defaults to the one matching the key, RS256 for RSA keys
+// and ES256 for P-256 keys.
+func ParsePrivateKey(pemData []byte, keyID, algorithm string) (*PrivateKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pk := PrivateKey{KeyID: keyID, Algorithm: algorithm, der: block.Bytes}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if pk.Algorithm == "" {
+			pk.Algorithm = SigningAlgorithmRS256
+		}
+		if pk.Algorithm != SigningAlgorithmRS256 {
+			return nil, fmt.Errorf("algorithm %s can't be used with an RSA key", pk.Algorithm)
+		}
+		pk.signer = k
+	case *ecdsa.PrivateKey:
+		if pk.Algorithm == "" {
+			pk.Algorithm = SigningAlgorithmES256
+		}
+		if pk.Algorithm != SigningAlgorithmES256 || k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("algorithm %s can't be used with a %s key", pk.Algorithm, k.Curve.Params().Name)
+		}
+		pk.signer = k
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return &pk, nil
+}
+
+// assertion returns a client assertion identifying clientID to the token
+// endpoint audience, signed with the key.
+func (k *PrivateKey) assertion(clientID, audience string) (string, error) {
+	header := map[string]string{
+		"alg": k.Algorithm,
+		"typ": "JWT",
+	}
+	if k.KeyID != "" {
+		header["kid"] = k.KeyID
+	}
+
+	now := time.Now()
+	claims := map[string]any{
+		"iss": clientID,
+		"sub": clientID,
+		"aud": audience,
+		"jti": uuid.NewString(),
+		"iat": now.Unix(),
+		"exp": now.Add(ClientAssertionLifetime).Unix(),
+	}
+
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(encodedHeader) + "." + base64.RawURLEncoding.EncodeToString(encodedClaims)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	switch signer := k.signer.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, signer, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", err
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, signer, digest[:])
+		if err != nil {
+			return "", err
+		}
+		// JWS signatures are the fixed size concatenation of R and S, see RFC 7518 section 3.4
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
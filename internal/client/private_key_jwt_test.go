@@ -0,0 +1,184 @@
+package client
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testPEM returns the PEM encoding of a key marshalled by marshal.
+func testPEM(t *testing.T, blockType string, marshal func() ([]byte, error)) []byte {
+	t.Helper()
+	der, err := marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+// testDecodeSegment decodes a base64url encoded JWT segment.
+func testDecodeSegment(t *testing.T, segment string) []byte {
+	t.Helper()
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestPrivateKeyAssertion(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifyRS256 := func(t *testing.T, digest, signature []byte) {
+		if err := rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, digest, signature); err != nil {
+			t.Errorf("RS256 signature doesn't verify: %s", err)
+		}
+	}
+	verifyES256 := func(t *testing.T, digest, signature []byte) {
+		// R and S are each left padded to 32 bytes
+		if len(signature) != 64 {
+			t.Fatalf("ES256 signature is %d bytes, want 64", len(signature))
+		}
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(&ecKey.PublicKey, digest, r, s) {
+			t.Error("ES256 signature doesn't verify")
+		}
+	}
+
+	tests := []struct {
+		name          string
+		pem           []byte
+		keyID         string
+		wantAlgorithm string
+		verify        func(t *testing.T, digest, signature []byte)
+	}{
+		{
+			name:          "RSA PKCS #1",
+			pem:           testPEM(t, "RSA PRIVATE KEY", func() ([]byte, error) { return x509.MarshalPKCS1PrivateKey(rsaKey), nil }),
+			keyID:         "rsa-key",
+			wantAlgorithm: SigningAlgorithmRS256,
+			verify:        verifyRS256,
+		},
+		{
+			name:          "RSA PKCS #8",
+			pem:           testPEM(t, "PRIVATE KEY", func() ([]byte, error) { return x509.MarshalPKCS8PrivateKey(rsaKey) }),
+			wantAlgorithm: SigningAlgorithmRS256,
+			verify:        verifyRS256,
+		},
+		{
+			name:          "P-256 SEC 1",
+			pem:           testPEM(t, "EC PRIVATE KEY", func() ([]byte, error) { return x509.MarshalECPrivateKey(ecKey) }),
+			keyID:         "ec-key",
+			wantAlgorithm: SigningAlgorithmES256,
+			verify:        verifyES256,
+		},
+		{
+			name:          "P-256 PKCS #8",
+			pem:           testPEM(t, "PRIVATE KEY", func() ([]byte, error) { return x509.MarshalPKCS8PrivateKey(ecKey) }),
+			wantAlgorithm: SigningAlgorithmES256,
+			verify:        verifyES256,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tt.pem, tt.keyID, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key.Algorithm != tt.wantAlgorithm {
+				t.Errorf("Algorithm = %s, want %s", key.Algorithm, tt.wantAlgorithm)
+			}
+
+			before := time.Now().Unix()
+			assertion, err := key.assertion("client", "https://auth.example.com/oauth/token")
+			if err != nil {
+				t.Fatal(err)
+			}
+			segments := strings.Split(assertion, ".")
+			if len(segments) != 3 {
+				t.Fatalf("assertion has %d segments, want 3", len(segments))
+			}
+
+			var header map[string]string
+			if err := json.Unmarshal(testDecodeSegment(t, segments[0]), &header); err != nil {
+				t.Fatal(err)
+			}
+			if header["alg"] != tt.wantAlgorithm || header["typ"] != "JWT" || header["kid"] != tt.keyID {
+				t.Errorf("header = %v, want alg %s, typ JWT and kid %q", header, tt.wantAlgorithm, tt.keyID)
+			}
+
+			var claims struct {
+				Issuer    string `json:"iss"`
+				Subject   string `json:"sub"`
+				Audience  string `json:"aud"`
+				ID        string `json:"jti"`
+				IssuedAt  int64  `json:"iat"`
+				ExpiresAt int64  `json:"exp"`
+			}
+			if err := json.Unmarshal(testDecodeSegment(t, segments[1]), &claims); err != nil {
+				t.Fatal(err)
+			}
+			if claims.Issuer != "client" || claims.Subject != "client" {
+				t.Errorf("iss, sub = %s, %s, want the client ID", claims.Issuer, claims.Subject)
+			}
+			if claims.Audience != "https://auth.example.com/oauth/token" {
+				t.Errorf("aud = %s, want the token endpoint", claims.Audience)
+			}
+			if claims.ID == "" {
+				t.Error("jti is missing")
+			}
+			if claims.IssuedAt < before || claims.ExpiresAt != claims.IssuedAt+int64(ClientAssertionLifetime.Seconds()) {
+				t.Errorf("iat, exp = %d, %d, want exp %s after iat", claims.IssuedAt, claims.ExpiresAt, ClientAssertionLifetime)
+			}
+
+			digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+			tt.verify(t, digest[:], testDecodeSegment(t, segments[2]))
+
+			// Assertions are never reused
+			other, err := key.assertion("client", "https://auth.example.com/oauth/token")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if other == assertion {
+				t.Error("assertion signed twice is identical, want a new jti")
+			}
+		})
+	}
+}
+
+func TestParsePrivateKeyAlgorithmMismatch(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ParsePrivateKey(testPEM(t, "EC PRIVATE KEY", func() ([]byte, error) { return x509.MarshalECPrivateKey(ecKey) }), "", ""); err == nil {
+		t.Error("ParsePrivateKey() of a P-384 key succeeded, want an error")
+	}
+	if _, err := ParsePrivateKey(testPEM(t, "RSA PRIVATE KEY", func() ([]byte, error) { return x509.MarshalPKCS1PrivateKey(rsaKey), nil }), "", SigningAlgorithmES256); err == nil {
+		t.Error("ParsePrivateKey() of an RSA key for ES256 succeeded, want an error")
+	}
+}
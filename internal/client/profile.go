@@ -0,0 +1,123 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// CredentialsFile - Path of the credentials file, relative to the home directory.
+const CredentialsFile string = ".config/quortex/credentials"
+
+// Profile - Named set of settings of the credentials file.
+type Profile struct {
+	Host           string `toml:"host"`
+	AuthServer     string `toml:"auth_server"`
+	ClientId       string `toml:"client_id"`
+	ClientSecret   string `toml:"client_secret"`
+	PrivateKeyFile string `toml:"private_key_file"`
+}
+
+// LoadProfile - Returns a specific profile of the credentials file.
+func LoadProfile(name string) (*Profile, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(home, CredentialsFile)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := ParseProfiles(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	return &profile, nil
+}
+
+// ParseProfiles - Parses credentials written as TOML tables or INI sections,
+// one per profile. Credentials are only parsed as INI when they are not
+// TOML, the TOML error is returned otherwise.
+func ParseProfiles(data []byte) (map[string]Profile, error) {
+	profiles := map[string]Profile{}
+	_, tomlErr := toml.Decode(string(data), &profiles)
+	if tomlErr == nil {
+		return profiles, nil
+	}
+
+	// INI values don't need to be quoted, credentials with only quoted values
+	// are malformed TOML
+	profiles, unquoted, err := parseINIProfiles(data)
+	if err != nil {
+		return nil, fmt.Errorf("neither TOML (%v) nor INI: %w", tomlErr, err)
+	}
+	if len(profiles) == 0 || !unquoted {
+		return nil, tomlErr
+	}
+	return profiles, nil
+}
+
+// parseINIProfiles parses credentials written as INI sections and reports
+// whether any value was unquoted.
+func parseINIProfiles(data []byte) (map[string]Profile, bool, error) {
+	profiles := map[string]Profile{}
+	unquoted := false
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			section = strings.TrimSpace(text[1 : len(text)-1])
+			profiles[section] = Profile{}
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok || section == "" {
+			return nil, false, fmt.Errorf("line %d: expected a [profile] section or a key = value pair", line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if !strings.HasPrefix(value, `"`) && !strings.HasPrefix(value, "'") {
+			unquoted = true
+		}
+		value = strings.Trim(value, `"'`)
+
+		profile := profiles[section]
+		switch key {
+		case "host":
+			profile.Host = value
+		case "auth_server":
+			profile.AuthServer = value
+		case "client_id":
+			profile.ClientId = value
+		case "client_secret":
+			profile.ClientSecret = value
+		case "private_key_file":
+			profile.PrivateKeyFile = value
+		}
+		profiles[section] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, err
+	}
+
+	return profiles, unquoted, nil
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseProfiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    map[string]Profile
+		wantErr string
+	}{
+		{
+			name: "toml",
+			data: "[default]\nhost = \"api.quortex.io\"\nclient_id = \"id\"\n\n[staging]\nhost = \"api.staging.quortex.io\"\n",
+			want: map[string]Profile{
+				"default": {Host: "api.quortex.io", ClientId: "id"},
+				"staging": {Host: "api.staging.quortex.io"},
+			},
+		},
+		{
+			name: "ini",
+			data: "; comment\n[default]\nhost = api.quortex.io\nclient_secret = 'secret'\n",
+			want: map[string]Profile{
+				"default": {Host: "api.quortex.io", ClientSecret: "secret"},
+			},
+		},
+		{
+			name:    "malformed toml",
+			data:    "[default]\nhost = \"api.quortex.io\"\nclient_secret = \"secret\"\nclient_secret = \"other\"\n",
+			wantErr: "client_secret",
+		},
+		{
+			name:    "neither toml nor ini",
+			data:    "host = api.quortex.io\n",
+			wantErr: "neither TOML",
+		},
+		{
+			name:    "unterminated toml string",
+			data:    "[default]\nclient_secret = \"secret\n",
+			wantErr: "toml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles, err := ParseProfiles([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseProfiles() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(profiles) != len(tt.want) {
+				t.Fatalf("ParseProfiles() = %v, want %v", profiles, tt.want)
+			}
+			for name, want := range tt.want {
+				if got := profiles[name]; got != want {
+					t.Errorf("ParseProfiles()[%q] = %+v, want %+v", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrQuoteUnavailable - The API does not support server side quoting.
+var ErrQuoteUnavailable = errors.New("server side quoting is not available")
+
+// CreateQuote - Computes a quote server side.
+//
+// Returns ErrQuoteUnavailable when the API does not support quoting.
+func (c *Client) CreateQuote(quoteRequest QuoteRequest) (*Quote, error) {
+	rb, err := json.Marshal(quoteRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/billing/quotes", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Quoting changes nothing, it is a POST only to carry the request body
+	body, err := c.doReadRequest(req)
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusNotFound || statusErr.StatusCode == http.StatusNotImplemented) {
+		return nil, ErrQuoteUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	quote := Quote{}
+	err = json.Unmarshal(body, &quote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// QuotePlan - Computes a quote locally from the pricing of a plan and an optional coupon.
+//
+// Amounts are computed with exact decimal arithmetic and rounded to cents.
+func QuotePlan(plan Plan, coupon *Coupon, quoteRequest QuoteRequest) (*Quote, error) {
+	if quoteRequest.Quantity < 1 {
+		return nil, fmt.Errorf("quantity must be at least 1, got %d", quoteRequest.Quantity)
+	}
+
+	var pricing *PrincingItem
+	for i, item := range plan.Pricing {
+		if item.SubscribeForYear == quoteRequest.SubscribeForYear && item.MonthlyPriceCurrency == quoteRequest.Currency {
+			pricing = &plan.Pricing[i]
+			break
+		}
+	}
+	if pricing == nil {
+		return nil, fmt.Errorf("plan %q has no pricing for %d year(s) in %s", plan.Name, quoteRequest.SubscribeForYear, quoteRequest.Currency)
+	}
+
+	months := pricing.SubscribeForYear * 12
+	monthly := decimal.NewFromFloat(pricing.MonthlyPrice).Mul(decimal.NewFromInt(int64(quoteRequest.Quantity)))
+
+	discount := decimal.Zero
+	discountedMonths := 0
+	if coupon != nil {
+		var err error
+		discount, discountedMonths, err = couponDiscount(*coupon, plan, monthly, quoteRequest.Currency, months)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	total := monthly.Mul(decimal.NewFromInt(int64(months))).
+		Sub(discount.Mul(decimal.NewFromInt(int64(discountedMonths))))
+
+	return &Quote{
+		Currency:           quoteRequest.Currency,
+		MonthlyPrice:       monthly.Round(2).InexactFloat64(),
+		MonthlyDiscount:    discount.Round(2).InexactFloat64(),
+		DiscountedMonths:   discountedMonths,
+		AnnualValue:        total.Div(decimal.NewFromInt(int64(pricing.SubscribeForYear))).Round(2).InexactFloat64(),
+		TotalContractValue: total.Round(2).InexactFloat64(),
+	}, nil
+}
+
+// couponDiscount returns the monthly discount of coupon on monthly and the
+// number of months of a contract of the given length it applies to.
+func couponDiscount(coupon Coupon, plan Plan, monthly decimal.Decimal, currency string, months int) (decimal.Decimal, int, error) {
+	if len(coupon.PlanIDs) > 0 {
+		applies := false
+		for _, planID := range coupon.PlanIDs {
+			if planID == plan.ID {
+				applies = true
+			}
+		}
+		if !applies {
+			return decimal.Zero, 0, fmt.Errorf("coupon %q does not apply to plan %q", coupon.Code, plan.Name)
+		}
+	}
+
+	var discount decimal.Decimal
+	if coupon.PercentOff != 0 {
+		discount = monthly.Mul(decimal.NewFromFloat(coupon.PercentOff)).Div(decimal.NewFromInt(100))
+	} else {
+		found := false
+		for _, amount := range coupon.AmountOff {
+			if amount.Currency == currency {
+				discount = decimal.NewFromFloat(amount.Amount)
+				found = true
+			}
+		}
+		if !found {
+			return decimal.Zero, 0, fmt.Errorf("coupon %q has no discount in %s", coupon.Code, currency)
+		}
+	}
+	if discount.GreaterThan(monthly) {
+		discount = monthly
+	}
+
+	switch coupon.Duration {
+	case "once":
+		return discount, 1, nil
+	case "repeating":
+		if coupon.DurationInMonths < months {
+			return discount, coupon.DurationInMonths, nil
+		}
+		return discount, months, nil
+	default:
+		return discount, months, nil
+	}
+}
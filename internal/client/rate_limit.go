@@ -0,0 +1,75 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter - Token bucket limiting the rate of requests, combined with a
+// cap on the number of requests in flight. A zero rate or cap disables the
+// matching limit.
+type RateLimiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	inFlight chan struct{}
+}
+
+// NewRateLimiter - Returns a limiter allowing requestsPerSecond requests per
+// second, in bursts of up to one second of requests, and maxConcurrent
+// requests in flight.
+func NewRateLimiter(requestsPerSecond float64, maxConcurrent int) *RateLimiter {
+	l := &RateLimiter{
+		rate:  requestsPerSecond,
+		burst: math.Max(1, math.Ceil(requestsPerSecond)),
+		last:  time.Now(),
+	}
+	l.tokens = l.burst
+	if maxConcurrent > 0 {
+		l.inFlight = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// Acquire - Waits until a request may be sent and returns the time waited.
+// Release must be called once the request completes.
+func (l *RateLimiter) Acquire() time.Duration {
+	start := time.Now()
+
+	if l.inFlight != nil {
+		l.inFlight <- struct{}{}
+	}
+
+	if l.rate > 0 {
+		time.Sleep(l.reserve(time.Now()))
+	}
+
+	return time.Since(start)
+}
+
+// Release - Frees the in flight slot taken by Acquire.
+func (l *RateLimiter) Release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// reserve takes a token from the bucket at now and returns how long to wait
+// for it to be available. Tokens may go negative so waiting requests queue in
+// order.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := NewRateLimiter(2, 0)
+	start := l.last
+
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  time.Duration
+	}{
+		// Burst of one second of requests
+		{name: "first of burst", after: 0, want: 0},
+		{name: "last of burst", after: 0, want: 0},
+		// Queued behind each other
+		{name: "first queued", after: 0, want: 500 * time.Millisecond},
+		{name: "second queued", after: 0, want: time.Second},
+		// Refilled by the time waited
+		{name: "after the queue", after: 1500 * time.Millisecond, want: 0},
+		{name: "refilled once", after: 2 * time.Second, want: 0},
+		{name: "refilled up to burst", after: 10 * time.Second, want: 0},
+		{name: "burst after idle", after: 10 * time.Second, want: 0},
+		{name: "over burst after idle", after: 10 * time.Second, want: 500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		if got := l.reserve(start.Add(tt.after)); got != tt.want {
+			t.Errorf("%s: reserve() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimiterInFlight(t *testing.T) {
+	l := NewRateLimiter(0, 2)
+	l.Acquire()
+	l.Acquire()
+
+	acquired := make(chan struct{})
+	go func() {
+		l.Acquire()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("Acquire() returned with 2 requests in flight, want it to wait")
+	default:
+	}
+
+	l.Release()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Acquire() still waiting after Release()")
+	}
+	if n := len(l.inFlight); n != 2 {
+		t.Errorf("%d requests in flight, want 2", n)
+	}
+}
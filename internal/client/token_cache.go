@@ -0,0 +1,167 @@
+package client
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TokenCacheMargin - Cached tokens expiring sooner than this are not reused.
+const TokenCacheMargin = time.Minute
+
+// CachedToken - Access token stored in the token cache.
+type CachedToken struct {
+	AccessToken string    `json:"access_token"`
+	Scope       string    `json:"scope"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// TokenCache - Directory of access tokens shared by provider processes. Each
+// token is encrypted with a key derived from the credentials it was issued
+// for, so that it can only be read back with the same credentials.
+type TokenCache struct {
+	Dir string
+}
+
+// NewTokenCache - Returns a token cache stored in dir, the user cache
+// directory when dir is empty.
+func NewTokenCache(dir string) (*TokenCache, error) {
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(cacheDir, "quortex", "administration", "tokens")
+	}
+	return &TokenCache{Dir: dir}, nil
+}
+
+// Load - Returns the cached token of the client, if it is still valid.
+func (tc *TokenCache) Load(c *Client) (*CachedToken, error) {
+	data, err := os.ReadFile(tc.path(c))
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := tokenCacheCipher(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("token cache entry is truncated")
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	token := CachedToken{}
+	if err := json.Unmarshal(plaintext, &token); err != nil {
+		return nil, err
+	}
+	if time.Until(token.ExpiresAt) < TokenCacheMargin {
+		return nil, errors.New("cached token is about to expire")
+	}
+	return &token, nil
+}
+
+// Store - Caches the token of the client, readable by the current user only.
+func (tc *TokenCache) Store(c *Client, token CachedToken) error {
+	plaintext, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := tokenCacheCipher(c)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	data := gcm.Seal(nonce, nonce, plaintext, nil)
+
+	if err := os.MkdirAll(tc.Dir, 0700); err != nil {
+		return err
+	}
+
+	// MkdirAll leaves the mode of an existing directory as is
+	info, err := os.Stat(tc.Dir)
+	if err != nil {
+		return err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		if err := os.Chmod(tc.Dir, 0700); err != nil {
+			return fmt.Errorf("token cache directory %s is accessible to other users: %w", tc.Dir, err)
+		}
+	}
+
+	// Write then rename so that concurrent readers never see a partial entry
+	f, err := os.CreateTemp(tc.Dir, ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0600); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), tc.path(c))
+}
+
+// Remove - Deletes the cached token of the client, such as when the API
+// rejects it before it expires.
+func (tc *TokenCache) Remove(c *Client) error {
+	err := os.Remove(tc.path(c))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// path returns the cache entry of the client, keyed by token endpoint, client
+// and requested scope and audience.
+func (tc *TokenCache) path(c *Client) string {
+	key := sha256.Sum256([]byte(c.tokenURL() + "\n" + c.Auth.ClientId + "\n" + c.Auth.Scope + "\n" + c.Auth.Audience))
+	return filepath.Join(tc.Dir, hex.EncodeToString(key[:])+".token")
+}
+
+// tokenCacheCipher returns the cipher of the cache entries of the client, its
+// key is derived from the client secret, or the private key with
+// private_key_jwt.
+func tokenCacheCipher(c *Client) (cipher.AEAD, error) {
+	secret := []byte(c.Auth.ClientSecret)
+	if c.TokenEndpointAuthMethod == TokenEndpointAuthPrivateKeyJWT && c.PrivateKey != nil {
+		secret = c.PrivateKey.der
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("token cache requires a client secret or a private key")
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("terraform-provider-administration token cache\n" + c.tokenURL() + "\n" + c.Auth.ClientId))
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestTokenCacheStoreTightensDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("directory permissions are not enforced with mode bits on Windows")
+	}
+
+	dir := filepath.Join(t.TempDir(), "tokens")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	tokenCache := &TokenCache{Dir: dir}
+	clientID, clientSecret, host := "client", "secret", "https://api.example.com"
+	c, err := NewClient(&host, &host, &clientID, &clientSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tokenCache.Store(c, CachedToken{AccessToken: "token", ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0700 {
+		t.Errorf("token cache directory mode = %o, want 700", mode)
+	}
+}
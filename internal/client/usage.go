@@ -0,0 +1,39 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetUsage - Returns the usage of an organization over a period.
+//
+// The API defaults from and to to the current billing period when empty.
+func (c *Client) GetUsage(organizationID, from, to string) (*Usage, error) {
+	query := url.Values{}
+	if from != "" {
+		query.Set("from", from)
+	}
+	if to != "" {
+		query.Set("to", to)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/billing/organizations/%s/usage?%s", c.HostURL, organizationID, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := Usage{}
+	err = json.Unmarshal(body, &usage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetWebhook - Returns a specific webhook.
+func (c *Client) GetWebhook(webhookID string) (*Webhook, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/1.0/manage/webhooks/%s", c.HostURL, webhookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := Webhook{}
+	err = json.Unmarshal(body, &webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// CreateWebhook - Create new webhook.
+func (c *Client) CreateWebhook(webhook Webhook) (*Webhook, error) {
+	rb, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/webhooks", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rwebhook := Webhook{}
+	err = json.Unmarshal(body, &rwebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rwebhook, nil
+}
+
+// UpdateWebhook - Updates a webhook.
+func (c *Client) UpdateWebhook(webhookID string, webhook Webhook) (*Webhook, error) {
+	rb, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/1.0/manage/webhooks/%s", c.HostURL, webhookID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rwebhook := Webhook{}
+	err = json.Unmarshal(body, &rwebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rwebhook, nil
+}
+
+// DeleteWebhook - Deletes a webhook.
+func (c *Client) DeleteWebhook(webhookID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/1.0/manage/webhooks/%s", c.HostURL, webhookID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RotateWebhookSecret - Generates a new signing secret for a webhook.
+func (c *Client) RotateWebhookSecret(webhookID string) (*Webhook, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/1.0/manage/webhooks/%s/rotate_secret", c.HostURL, webhookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rwebhook := Webhook{}
+	err = json.Unmarshal(body, &rwebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rwebhook, nil
+}
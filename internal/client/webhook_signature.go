@@ -0,0 +1,31 @@
+package client
+
+import (
+	"time"
+
+	"github.com/quortex/terraform-provider-administration/webhook"
+)
+
+// WebhookSignatureHeader - Header carrying the signature of webhook deliveries.
+const WebhookSignatureHeader = webhook.SignatureHeader
+
+// DefaultWebhookTolerance - Maximum age of a webhook delivery accepted by VerifyWebhookSignature.
+const DefaultWebhookTolerance = webhook.DefaultTolerance
+
+var (
+	ErrWebhookSignatureHeader  = webhook.ErrSignatureHeader
+	ErrWebhookSignatureExpired = webhook.ErrSignatureExpired
+	ErrWebhookSignatureInvalid = webhook.ErrSignatureInvalid
+)
+
+// SignWebhookPayload - Returns the signature header value of a payload sent
+// at the given time, see webhook.SignPayload.
+func SignWebhookPayload(secret string, payload []byte, timestamp time.Time) string {
+	return webhook.SignPayload(secret, payload, timestamp)
+}
+
+// VerifyWebhookSignature - Checks the signature header of a webhook delivery,
+// see webhook.VerifySignature.
+func VerifyWebhookSignature(secret string, payload []byte, header string, tolerance time.Duration) error {
+	return webhook.VerifySignature(secret, payload, header, tolerance)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type accessTokenEphemeralResourceModel struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	Scope       types.String `tfsdk:"scope"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &accessTokenEphemeralResource{}
+)
+
+// NewAccessTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+// accessTokenEphemeralResource is the ephemeral resource implementation.
+type accessTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *accessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *accessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a bearer token for the Administration API with the provider credentials, without storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Description: "Bearer token for the Administration API.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_type": schema.StringAttribute{
+				Description: "Type of the token, usually Bearer.",
+				Computed:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Space separated list of scopes granted to the token, null when the token endpoint returned none.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the expiry of the token.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Open issues a new token.
+func (e *accessTokenEphemeralResource) Open(ctx context.Context, _ ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if unconfiguredGuard(e.client, "issue access token", &resp.Diagnostics) {
+		return
+	}
+
+	ar, err := e.client.SignIn()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Issue Administration Access Token",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	result := accessTokenEphemeralResourceModel{
+		AccessToken: types.StringValue(ar.AccessToken),
+		TokenType:   types.StringValue(ar.TokenType),
+		Scope:       types.StringNull(),
+		ExpiresAt:   types.StringValue(time.Now().Add(time.Duration(ar.ExpiresIn) * time.Second).UTC().Format(time.RFC3339)),
+	}
+	if ar.Scope != "" {
+		result.Scope = types.StringValue(ar.Scope)
+	}
+
+	// Set result
+	diags := resp.Result.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *accessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type auditEventModel struct {
+	ID           types.String `tfsdk:"id"`
+	OccurredAt   types.String `tfsdk:"occurred_at"`
+	Actor        types.String `tfsdk:"actor"`
+	ActorType    types.String `tfsdk:"actor_type"`
+	Action       types.String `tfsdk:"action"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	RequestID    types.String `tfsdk:"request_id"`
+	Changes      types.String `tfsdk:"changes"`
+}
+
+type auditEventsDataSourceModel struct {
+	ResourceType types.String      `tfsdk:"resource_type"`
+	ResourceID   types.String      `tfsdk:"resource_id"`
+	Actor        types.String      `tfsdk:"actor"`
+	From         types.String      `tfsdk:"from"`
+	To           types.String      `tfsdk:"to"`
+	Events       []auditEventModel `tfsdk:"events"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &auditEventsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &auditEventsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &auditEventsDataSource{}
+)
+
+// NewAuditEventsDataSource is a helper function to simplify the provider implementation.
+func NewAuditEventsDataSource() datasource.DataSource {
+	return &auditEventsDataSource{}
+}
+
+// auditEventsDataSource is the data source implementation.
+type auditEventsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *auditEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_events"
+}
+
+// Schema defines the schema for the data source.
+func (d *auditEventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the events of the administration audit log.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				Description: "Only return events on this type of resource, such as billing_plan, coupon, limit_override or webhook.",
+				Optional:    true,
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "Only return events on the resource with this identifier.",
+				Optional:    true,
+			},
+			"actor": schema.StringAttribute{
+				Description: "Only return events performed by this user or client.",
+				Optional:    true,
+			},
+			"from": schema.StringAttribute{
+				Description: "RFC 3339 timestamp, only return events that occurred at or after it.",
+				Optional:    true,
+			},
+			"to": schema.StringAttribute{
+				Description: "RFC 3339 timestamp, only return events that occurred before it.",
+				Optional:    true,
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "List of audit events, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the event.",
+							Computed:    true,
+						},
+						"occurred_at": schema.StringAttribute{
+							Description: "Timestamp of the event.",
+							Computed:    true,
+						},
+						"actor": schema.StringAttribute{
+							Description: "User or client that performed the action.",
+							Computed:    true,
+						},
+						"actor_type": schema.StringAttribute{
+							Description: "Type of actor, such as user or client.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Performed action, such as create, update or delete.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "Type of the affected resource.",
+							Computed:    true,
+						},
+						"resource_id": schema.StringAttribute{
+							Description: "Identifier of the affected resource.",
+							Computed:    true,
+						},
+						"request_id": schema.StringAttribute{
+							Description: "Identifier of the API request that performed the action, if known.",
+							Computed:    true,
+						},
+						"changes": schema.StringAttribute{
+							Description: "JSON encoded description of the changed fields.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that from and to are valid RFC 3339 timestamps.
+func (d *auditEventsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config auditEventsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimestamp(config.From, path.Root("from"), &resp.Diagnostics)
+	validateTimestamp(config.To, path.Root("to"), &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *auditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if unconfiguredGuard(d.client, "read audit events", &resp.Diagnostics) {
+		return
+	}
+
+	var state auditEventsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, err := d.client.GetAuditEvents(client.AuditEventFilter{
+		ResourceType: state.ResourceType.ValueString(),
+		ResourceID:   state.ResourceID.ValueString(),
+		Actor:        state.Actor.ValueString(),
+		From:         state.From.ValueString(),
+		To:           state.To.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Audit Events",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Events = []auditEventModel{}
+	for _, event := range events {
+		model := auditEventModel{
+			ID:           types.StringValue(event.ID),
+			OccurredAt:   types.StringValue(event.OccurredAt),
+			Actor:        types.StringValue(event.Actor),
+			ActorType:    types.StringValue(event.ActorType),
+			Action:       types.StringValue(event.Action),
+			ResourceType: types.StringValue(event.ResourceType),
+			ResourceID:   types.StringValue(event.ResourceID),
+			RequestID:    types.StringNull(),
+			Changes:      types.StringNull(),
+		}
+		if event.RequestID != "" {
+			model.RequestID = types.StringValue(event.RequestID)
+		}
+		if len(event.Changes) > 0 {
+			model.Changes = types.StringValue(string(event.Changes))
+		}
+		state.Events = append(state.Events, model)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *auditEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type callerIdentityDataSourceModel struct {
+	ClientID       types.String   `tfsdk:"client_id"`
+	PrincipalID    types.String   `tfsdk:"principal_id"`
+	PrincipalType  types.String   `tfsdk:"principal_type"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Scopes         []types.String `tfsdk:"scopes"`
+	ExpiresAt      types.String   `tfsdk:"expires_at"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &callerIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure = &callerIdentityDataSource{}
+)
+
+// NewCallerIdentityDataSource is a helper function to simplify the provider implementation.
+func NewCallerIdentityDataSource() datasource.DataSource {
+	return &callerIdentityDataSource{}
+}
+
+// callerIdentityDataSource is the data source implementation.
+type callerIdentityDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *callerIdentityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caller_identity"
+}
+
+// Schema defines the schema for the data source.
+func (d *callerIdentityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the identity of the principal the provider credentials map to.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "ClientId the provider is configured with.",
+				Computed:    true,
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "Identifier of the principal.",
+				Computed:    true,
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "Type of the principal, such as user or client.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization of the principal.",
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				Description: "List of scopes granted to the access token.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the expiry of the access token.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *callerIdentityDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if unconfiguredGuard(d.client, "read caller identity", &resp.Diagnostics) {
+		return
+	}
+
+	identity, err := d.client.GetCallerIdentity()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Caller Identity",
+			err.Error(),
+		)
+		return
+	}
+
+	token, err := d.client.Token()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Caller Identity",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body and token details to model
+	state := callerIdentityDataSourceModel{
+		ClientID:       types.StringValue(d.client.Auth.ClientId),
+		PrincipalID:    types.StringValue(identity.PrincipalID),
+		PrincipalType:  types.StringValue(identity.PrincipalType),
+		OrganizationID: types.StringValue(identity.OrganizationID),
+		Scopes:         []types.String{},
+		ExpiresAt:      types.StringValue(token.ExpiresAt.UTC().Format(time.RFC3339)),
+	}
+	for _, scope := range strings.Fields(token.Scope) {
+		state.Scopes = append(state.Scopes, types.StringValue(scope))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *callerIdentityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
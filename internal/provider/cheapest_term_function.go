@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shopspring/decimal"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &cheapestTermFunction{}
+
+// NewCheapestTermFunction is a helper function to simplify the provider implementation.
+func NewCheapestTermFunction() function.Function {
+	return &cheapestTermFunction{}
+}
+
+// cheapestTermFunction is the function implementation.
+type cheapestTermFunction struct{}
+
+// Metadata returns the function name.
+func (f *cheapestTermFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cheapest_term"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *cheapestTermFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Pricing item with the lowest monthly price.",
+		Description: "Returns the pricing item with the lowest monthly_price, the shortest term wins ties. " +
+			"When a currency is given, pricing items in other currencies are ignored, otherwise all pricing items must share the same currency.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "pricing",
+				Description: "Pricing of a plan.",
+				ElementType: types.ObjectType{AttrTypes: pricingItemAttrTypes},
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "currency",
+			Description: "Optional currency of the pricing items to compare.",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: pricingItemAttrTypes,
+		},
+	}
+}
+
+// Run selects the cheapest pricing item.
+func (f *cheapestTermFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pricing []pricingFunctionItem
+	var currencies []string
+	resp.Error = req.Arguments.Get(ctx, &pricing, &currencies)
+	if resp.Error != nil {
+		return
+	}
+
+	if len(pricing) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "pricing must contain at least one item")
+		return
+	}
+
+	var currency string
+	switch len(currencies) {
+	case 0:
+		// Without currency, the pricing items must share one
+		currency = pricing[0].MonthlyPriceCurrency.ValueString()
+		for _, item := range pricing {
+			if item.MonthlyPriceCurrency.ValueString() != currency {
+				resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+					"pricing items must share the same currency, got %s and %s, pass the currency to compare as second argument",
+					currency, item.MonthlyPriceCurrency.ValueString(),
+				))
+				return
+			}
+		}
+	case 1:
+		currency = currencies[0]
+	default:
+		resp.Error = function.NewArgumentFuncError(1, "at most one currency may be given")
+		return
+	}
+
+	cheapest := -1
+	var cheapestPrice, cheapestYears decimal.Decimal
+	for i, item := range pricing {
+		if item.MonthlyPriceCurrency.ValueString() != currency {
+			continue
+		}
+
+		price, err := numberToDecimal(item.MonthlyPrice.ValueBigFloat())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("pricing[%d].monthly_price: %s", i, err))
+			return
+		}
+
+		years, err := numberToDecimal(item.SubscribeForYear.ValueBigFloat())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("pricing[%d].subscribe_for_year: %s", i, err))
+			return
+		}
+
+		if cheapest < 0 || price.LessThan(cheapestPrice) || (price.Equal(cheapestPrice) && years.LessThan(cheapestYears)) {
+			cheapest, cheapestPrice, cheapestYears = i, price, years
+		}
+	}
+
+	if cheapest < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "pricing must contain at least one item in "+currency)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, pricing[cheapest])
+}
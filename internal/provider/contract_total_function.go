@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &contractTotalFunction{}
+
+// NewContractTotalFunction is a helper function to simplify the provider implementation.
+func NewContractTotalFunction() function.Function {
+	return &contractTotalFunction{}
+}
+
+// contractTotalFunction is the function implementation.
+type contractTotalFunction struct{}
+
+// Metadata returns the function name.
+func (f *contractTotalFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "contract_total"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *contractTotalFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Total value of a pricing item over its term.",
+		Description: "Returns monthly_price multiplied by the number of months of subscribe_for_year, computed with exact decimal arithmetic.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "pricing_item",
+				Description:    "Pricing item of a plan.",
+				AttributeTypes: pricingItemAttrTypes,
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+// Run computes the total value of the pricing item.
+func (f *contractTotalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var item pricingFunctionItem
+	resp.Error = req.Arguments.Get(ctx, &item)
+	if resp.Error != nil {
+		return
+	}
+
+	total, err := contractTotal(item)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, decimalToNumber(total))
+}
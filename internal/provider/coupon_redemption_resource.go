@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type couponRedemptionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	CouponID       types.String `tfsdk:"coupon_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	RedeemedAt     types.String `tfsdk:"redeemed_at"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &couponRedemptionResource{}
+	_ resource.ResourceWithConfigure   = &couponRedemptionResource{}
+	_ resource.ResourceWithImportState = &couponRedemptionResource{}
+)
+
+// NewCouponRedemptionResource is a helper function to simplify the provider implementation.
+func NewCouponRedemptionResource() resource.Resource {
+	return &couponRedemptionResource{}
+}
+
+// couponRedemptionResource is the resource implementation.
+type couponRedemptionResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *couponRedemptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon_redemption"
+}
+
+// Schema defines the schema for the resource.
+func (r *couponRedemptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Redeems a coupon for an organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the coupon redemption.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"coupon_id": schema.StringAttribute{
+				Description: "Numeric identifier of the redeemed coupon.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization redeeming the coupon.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"redeemed_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the redemption.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func CouponRedemptionToCouponRedemptionModel(redemption client.CouponRedemption, model *couponRedemptionResourceModel) {
+	model.ID = types.StringValue(strconv.Itoa(redemption.ID))
+	model.CouponID = types.StringValue(strconv.Itoa(redemption.CouponID))
+	model.OrganizationID = types.StringValue(redemption.OrganizationID)
+	model.RedeemedAt = types.StringValue(redemption.RedeemedAt)
+}
+
+// Create a new resource.
+func (r *couponRedemptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create coupon redemption", &resp.Diagnostics) || unconfiguredGuard(r.client, "create coupon redemption", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan couponRedemptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	couponID, err := strconv.Atoi(plan.CouponID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("coupon_id"),
+			"Invalid Coupon ID",
+			"Coupon identifiers must be numeric, got: "+plan.CouponID.ValueString(),
+		)
+		return
+	}
+
+	// Redeem the coupon
+	rredemption, err := r.client.CreateCouponRedemption(client.CouponRedemption{
+		CouponID:       couponID,
+		OrganizationID: plan.OrganizationID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating coupon redemption",
+			"Could not redeem coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	CouponRedemptionToCouponRedemptionModel(*rredemption, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *couponRedemptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
+	// Get current state
+	var state couponRedemptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed coupon redemption value from Administration
+	rredemption, err := r.client.GetCouponRedemption(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Coupon Redemption",
+			"Could not read Administration coupon redemption ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	CouponRedemptionToCouponRedemptionModel(*rredemption, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called as every attribute requires replacement.
+func (r *couponRedemptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update coupon redemption", &resp.Diagnostics) || unconfiguredGuard(r.client, "update coupon redemption", &resp.Diagnostics) {
+		return
+	}
+
+	var plan couponRedemptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *couponRedemptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete coupon redemption", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete coupon redemption", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from state
+	var state couponRedemptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing coupon redemption
+	err := r.client.DeleteCouponRedemption(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Administration Coupon Redemption",
+			"Could not delete coupon redemption, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *couponRedemptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *couponRedemptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,535 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type couponAmountModel struct {
+	Amount   types.Float64 `tfsdk:"amount"`
+	Currency types.String  `tfsdk:"currency"`
+}
+
+type couponResourceModel struct {
+	ID               types.String        `tfsdk:"id"`
+	OrganizationID   types.String        `tfsdk:"organization_id"`
+	Code             types.String        `tfsdk:"code"`
+	LastUpdated      types.String        `tfsdk:"last_updated"`
+	PercentOff       types.Float64       `tfsdk:"percent_off"`
+	AmountOff        []couponAmountModel `tfsdk:"amount_off"`
+	Duration         types.String        `tfsdk:"duration"`
+	DurationInMonths types.Int64         `tfsdk:"duration_in_months"`
+	MaxRedemptions   types.Int64         `tfsdk:"max_redemptions"`
+	PlanIDs          []types.String      `tfsdk:"plan_ids"`
+	ValidFrom        types.String        `tfsdk:"valid_from"`
+	ValidUntil       types.String        `tfsdk:"valid_until"`
+}
+
+// couponDurations lists the durations accepted by the API.
+var couponDurations = []string{"once", "repeating", "forever"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &couponResource{}
+	_ resource.ResourceWithConfigure      = &couponResource{}
+	_ resource.ResourceWithImportState    = &couponResource{}
+	_ resource.ResourceWithValidateConfig = &couponResource{}
+	_ resource.ResourceWithModifyPlan     = &couponResource{}
+)
+
+// NewCouponResource is a helper function to simplify the provider implementation.
+func NewCouponResource() resource.Resource {
+	return &couponResource{}
+}
+
+// couponResource is the resource implementation.
+type couponResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *couponResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon"
+}
+
+// Schema defines the schema for the resource.
+func (r *couponResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a coupon.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the coupon.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute(),
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+			"code": schema.StringAttribute{
+				Description: "Code customers use to redeem the coupon.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"percent_off": schema.Float64Attribute{
+				Description: "Percentage discounted from the price. Conflicts with amount_off.",
+				Optional:    true,
+			},
+			"amount_off": schema.ListNestedAttribute{
+				Description: "Fixed amount discounted from the monthly price, per currency. Conflicts with percent_off.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"amount": schema.Float64Attribute{
+							Description: "Discounted amount.",
+							Required:    true,
+						},
+						"currency": schema.StringAttribute{
+							Description: "Currency of the discounted amount.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"duration": schema.StringAttribute{
+				Description: "How long the discount applies, one of once, repeating or forever.",
+				Required:    true,
+			},
+			"duration_in_months": schema.Int64Attribute{
+				Description: "Number of months the discount applies. Required when duration is repeating.",
+				Optional:    true,
+			},
+			"max_redemptions": schema.Int64Attribute{
+				Description: "Maximum number of times the coupon can be redeemed. Unlimited when omitted.",
+				Optional:    true,
+			},
+			"plan_ids": schema.ListAttribute{
+				Description: "Numeric identifiers of the plans the coupon applies to. Applies to every plan when omitted.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"valid_from": schema.StringAttribute{
+				Description: "RFC 3339 timestamp from which the coupon can be redeemed.",
+				Optional:    true,
+			},
+			"valid_until": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the coupon can no longer be redeemed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the discount and duration settings of the coupon.
+func (r *couponResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var percentOff types.Float64
+	var amountOff types.List
+	var duration types.String
+	var durationInMonths types.Int64
+	var validFrom, validUntil types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("percent_off"), &percentOff)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("amount_off"), &amountOff)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duration"), &duration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duration_in_months"), &durationInMonths)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("valid_from"), &validFrom)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("valid_until"), &validUntil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !percentOff.IsUnknown() && !amountOff.IsUnknown() && percentOff.IsNull() == amountOff.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("percent_off"),
+			"Invalid Coupon Discount",
+			"Exactly one of percent_off or amount_off must be set.",
+		)
+	}
+
+	if !percentOff.IsNull() && !percentOff.IsUnknown() {
+		if v := percentOff.ValueFloat64(); v <= 0 || v > 100 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("percent_off"),
+				"Invalid Coupon Discount",
+				fmt.Sprintf("The percent_off value must be greater than 0 and at most 100, got: %g.", v),
+			)
+		}
+	}
+
+	if !duration.IsNull() && !duration.IsUnknown() {
+		valid := false
+		for _, d := range couponDurations {
+			if duration.ValueString() == d {
+				valid = true
+			}
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration"),
+				"Invalid Coupon Duration",
+				fmt.Sprintf("The duration value must be one of %v, got: %q.", couponDurations, duration.ValueString()),
+			)
+		}
+
+		if duration.ValueString() == "repeating" && durationInMonths.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration_in_months"),
+				"Missing Coupon Duration",
+				"The duration_in_months value must be set when duration is repeating.",
+			)
+		}
+		if duration.ValueString() != "repeating" && !durationInMonths.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration_in_months"),
+				"Invalid Coupon Duration",
+				"The duration_in_months value can only be set when duration is repeating.",
+			)
+		}
+	}
+
+	validateTimestamp(validFrom, path.Root("valid_from"), &resp.Diagnostics)
+	validateTimestamp(validUntil, path.Root("valid_until"), &resp.Diagnostics)
+}
+
+// ModifyPlan checks that the referenced plans exist and are priced in the
+// currencies of amount_off.
+func (r *couponResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var organizationID types.String
+	var planIDs, amountOff types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization_id"), &organizationID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("plan_ids"), &planIDs)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("amount_off"), &amountOff)...)
+	if resp.Diagnostics.HasError() || organizationID.IsUnknown() || planIDs.IsNull() || planIDs.IsUnknown() {
+		return
+	}
+
+	var ids []types.String
+	resp.Diagnostics.Append(planIDs.ElementsAs(ctx, &ids, false)...)
+	var amounts []couponAmountModel
+	if !amountOff.IsNull() && !amountOff.IsUnknown() {
+		resp.Diagnostics.Append(amountOff.ElementsAs(ctx, &amounts, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, id := range ids {
+		if id.IsUnknown() {
+			continue
+		}
+
+		rplan, err := organizationClient(r.client, organizationID).GetPlan(id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("plan_ids").AtListIndex(i),
+				"Unknown Administration Plan",
+				"Could not read Administration plan ID "+id.ValueString()+" referenced by the coupon: "+err.Error(),
+			)
+			continue
+		}
+
+		for _, amount := range amounts {
+			if amount.Currency.IsUnknown() || planHasCurrency(*rplan, amount.Currency.ValueString()) {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("amount_off"),
+				"Unknown Coupon Currency",
+				fmt.Sprintf("Plan %s (%s) has no pricing in currency %s.", id.ValueString(), rplan.Name, amount.Currency.ValueString()),
+			)
+		}
+	}
+}
+
+// planHasCurrency reports whether one of the pricing items of the plan is in
+// the given currency.
+func planHasCurrency(plan client.Plan, currency string) bool {
+	for _, pricing := range plan.Pricing {
+		if pricing.MonthlyPriceCurrency == currency {
+			return true
+		}
+	}
+	return false
+}
+
+func CouponModelToCoupon(model couponResourceModel) (*client.Coupon, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	coupon := client.Coupon{
+		Code:             model.Code.ValueString(),
+		PercentOff:       model.PercentOff.ValueFloat64(),
+		Duration:         model.Duration.ValueString(),
+		DurationInMonths: int(model.DurationInMonths.ValueInt64()),
+		MaxRedemptions:   int(model.MaxRedemptions.ValueInt64()),
+		ValidFrom:        model.ValidFrom.ValueString(),
+		ValidUntil:       model.ValidUntil.ValueString(),
+	}
+
+	for _, item := range model.AmountOff {
+		coupon.AmountOff = append(coupon.AmountOff, client.CouponAmount{
+			Amount:   item.Amount.ValueFloat64(),
+			Currency: item.Currency.ValueString(),
+		})
+	}
+
+	coupon.PlanIDs = []int{}
+	for i, item := range model.PlanIDs {
+		planID, err := strconv.Atoi(item.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("plan_ids").AtListIndex(i),
+				"Invalid Plan ID",
+				"Plan identifiers must be numeric, got: "+item.ValueString(),
+			)
+			continue
+		}
+		coupon.PlanIDs = append(coupon.PlanIDs, planID)
+	}
+
+	return &coupon, diags
+}
+
+func CouponToCouponModel(coupon client.Coupon, model *couponResourceModel) {
+	model.Code = types.StringValue(coupon.Code)
+	model.Duration = types.StringValue(coupon.Duration)
+
+	model.PercentOff = types.Float64Null()
+	if coupon.PercentOff != 0 {
+		model.PercentOff = types.Float64Value(coupon.PercentOff)
+	}
+
+	// Empty lists come back from the API as null, keep the empty list of the
+	// plan or state so that it is not reported as a change
+	amountOff := model.AmountOff
+	model.AmountOff = nil
+	if amountOff != nil {
+		model.AmountOff = []couponAmountModel{}
+	}
+	for _, item := range coupon.AmountOff {
+		model.AmountOff = append(model.AmountOff, couponAmountModel{
+			Amount:   types.Float64Value(item.Amount),
+			Currency: types.StringValue(item.Currency),
+		})
+	}
+
+	model.DurationInMonths = types.Int64Null()
+	if coupon.DurationInMonths != 0 {
+		model.DurationInMonths = types.Int64Value(int64(coupon.DurationInMonths))
+	}
+
+	model.MaxRedemptions = types.Int64Null()
+	if coupon.MaxRedemptions != 0 {
+		model.MaxRedemptions = types.Int64Value(int64(coupon.MaxRedemptions))
+	}
+
+	planIDs := model.PlanIDs
+	model.PlanIDs = nil
+	if planIDs != nil {
+		model.PlanIDs = []types.String{}
+	}
+	for _, planID := range coupon.PlanIDs {
+		model.PlanIDs = append(model.PlanIDs, types.StringValue(strconv.Itoa(planID)))
+	}
+
+	model.ValidFrom = types.StringNull()
+	if coupon.ValidFrom != "" {
+		model.ValidFrom = types.StringValue(coupon.ValidFrom)
+	}
+
+	model.ValidUntil = types.StringNull()
+	if coupon.ValidUntil != "" {
+		model.ValidUntil = types.StringValue(coupon.ValidUntil)
+	}
+
+	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// Create a new resource.
+func (r *couponResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create coupon", &resp.Diagnostics) || unconfiguredGuard(r.client, "create coupon", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan couponResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newCoupon, diags := CouponModelToCoupon(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new coupon
+	rcoupon, err := organizationClient(r.client, plan.OrganizationID).CreateCoupon(*newCoupon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating coupon",
+			"Could not create coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(rcoupon.ID))
+	CouponToCouponModel(*rcoupon, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *couponResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
+	// Get current state
+	var state couponResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed coupon value from Administration
+	rcoupon, err := organizationClient(r.client, state.OrganizationID).GetCoupon(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Coupon",
+			"Could not read Administration coupon ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(rcoupon.ID))
+	CouponToCouponModel(*rcoupon, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *couponResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update coupon", &resp.Diagnostics) || unconfiguredGuard(r.client, "update coupon", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan couponResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newCoupon, diags := CouponModelToCoupon(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing coupon
+	rcoupon, err := organizationClient(r.client, plan.OrganizationID).UpdateCoupon(plan.ID.ValueString(), *newCoupon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Administration Coupon",
+			"Could not update coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	CouponToCouponModel(*rcoupon, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *couponResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete coupon", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete coupon", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from state
+	var state couponResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing coupon
+	err := organizationClient(r.client, state.OrganizationID).DeleteCoupon(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Administration Coupon",
+			"Could not delete coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *couponResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *couponResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
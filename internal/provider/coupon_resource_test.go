@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+func TestCouponToCouponModelEmptyLists(t *testing.T) {
+	coupon := client.Coupon{Code: "WELCOME", Duration: "once", PercentOff: 10}
+
+	tests := []struct {
+		name      string
+		prior     couponResourceModel
+		wantEmpty bool
+	}{
+		{
+			name:  "null lists stay null",
+			prior: couponResourceModel{},
+		},
+		{
+			name: "empty lists stay empty",
+			prior: couponResourceModel{
+				AmountOff: []couponAmountModel{},
+				PlanIDs:   []types.String{},
+			},
+			wantEmpty: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := tt.prior
+			CouponToCouponModel(coupon, &model)
+
+			if (model.PlanIDs != nil) != tt.wantEmpty || len(model.PlanIDs) != 0 {
+				t.Errorf("plan_ids = %#v, want empty list %t", model.PlanIDs, tt.wantEmpty)
+			}
+			if (model.AmountOff != nil) != tt.wantEmpty || len(model.AmountOff) != 0 {
+				t.Errorf("amount_off = %#v, want empty list %t", model.AmountOff, tt.wantEmpty)
+			}
+		})
+	}
+}
+
+func TestCouponToCouponModelPlanIDs(t *testing.T) {
+	model := couponResourceModel{PlanIDs: []types.String{}}
+	CouponToCouponModel(client.Coupon{Code: "WELCOME", Duration: "once", PercentOff: 10, PlanIDs: []int{1, 2}}, &model)
+
+	if len(model.PlanIDs) != 2 || model.PlanIDs[0].ValueString() != "1" || model.PlanIDs[1].ValueString() != "2" {
+		t.Errorf("plan_ids = %v, want [1 2]", model.PlanIDs)
+	}
+}
+
+// The framework decodes an empty list to an empty slice and a null list to a
+// nil slice, and encodes them back the same way.
+func TestEmptyListSliceMapping(t *testing.T) {
+	ctx := context.Background()
+
+	var empty, null []types.String
+	if diags := tfsdk.ValueAs(ctx, types.ListValueMust(types.StringType, []attr.Value{}), &empty); diags.HasError() || empty == nil {
+		t.Errorf("empty list decoded to %#v, %v", empty, diags)
+	}
+	if diags := tfsdk.ValueAs(ctx, types.ListNull(types.StringType), &null); diags.HasError() || null != nil {
+		t.Errorf("null list decoded to %#v, %v", null, diags)
+	}
+
+	value, diags := types.ListValueFrom(ctx, types.StringType, []types.String{})
+	if diags.HasError() || value.IsNull() {
+		t.Errorf("empty slice encoded to %s, %v", value, diags)
+	}
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type effectiveLimitItemModel struct {
+	Name       types.String `tfsdk:"name"`
+	Value      types.Int64  `tfsdk:"value"`
+	PlanValue  types.Int64  `tfsdk:"plan_value"`
+	Overridden types.Bool   `tfsdk:"overridden"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+type effectiveLimitsDataSourceModel struct {
+	OrganizationID types.String              `tfsdk:"organization_id"`
+	PlanID         types.String              `tfsdk:"plan_id"`
+	Limits         []effectiveLimitItemModel `tfsdk:"limits"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &effectiveLimitsDataSource{}
+	_ datasource.DataSourceWithConfigure = &effectiveLimitsDataSource{}
+)
+
+// NewEffectiveLimitsDataSource is a helper function to simplify the provider implementation.
+func NewEffectiveLimitsDataSource() datasource.DataSource {
+	return &effectiveLimitsDataSource{}
+}
+
+// effectiveLimitsDataSource is the data source implementation.
+type effectiveLimitsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *effectiveLimitsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_limits"
+}
+
+// Schema defines the schema for the data source.
+func (d *effectiveLimitsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the limits of an organization once limit overrides are applied.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+			},
+			"plan_id": schema.StringAttribute{
+				Description: "Numeric identifier of the plan the organization is subscribed to.",
+				Computed:    true,
+			},
+			"limits": schema.ListNestedAttribute{
+				Description: "List of effective limits of the organization.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of limit.",
+							Computed:    true,
+						},
+						"value": schema.Int64Attribute{
+							Description: "Effective value of limit.",
+							Computed:    true,
+						},
+						"plan_value": schema.Int64Attribute{
+							Description: "Value of limit as defined by the plan.",
+							Computed:    true,
+						},
+						"overridden": schema.BoolAttribute{
+							Description: "Whether a limit override applies.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Expiry of the applied limit override, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *effectiveLimitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if unconfiguredGuard(d.client, "read effective limits", &resp.Diagnostics) {
+		return
+	}
+
+	var state effectiveLimitsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limits, err := d.client.GetEffectiveLimits(state.OrganizationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Effective Limits",
+			"Could not read effective limits of organization "+state.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.PlanID = types.StringValue(strconv.Itoa(limits.PlanID))
+	state.Limits = []effectiveLimitItemModel{}
+	for _, item := range limits.Limits {
+		limit := effectiveLimitItemModel{
+			Name:       types.StringValue(item.Name),
+			Value:      types.Int64Value(int64(item.Value)),
+			PlanValue:  types.Int64Value(int64(item.PlanValue)),
+			Overridden: types.BoolValue(item.Overridden),
+			ExpiresAt:  types.StringNull(),
+		}
+		if item.ExpiresAt != "" {
+			limit.ExpiresAt = types.StringValue(item.ExpiresAt)
+		}
+		state.Limits = append(state.Limits, limit)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *effectiveLimitsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type featureResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Key            types.String `tfsdk:"key"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	Description    types.String `tfsdk:"description"`
+	Category       types.String `tfsdk:"category"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &featureResource{}
+	_ resource.ResourceWithConfigure   = &featureResource{}
+	_ resource.ResourceWithImportState = &featureResource{}
+)
+
+// NewFeatureResource is a helper function to simplify the provider implementation.
+func NewFeatureResource() resource.Resource {
+	return &featureResource{}
+}
+
+// featureResource is the resource implementation.
+type featureResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *featureResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature"
+}
+
+// Schema defines the schema for the resource.
+func (r *featureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a feature of the catalog plans pick their features from.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the feature, same as key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute(),
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the feature, as referenced in the features of a plan.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Human readable name of the feature.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the feature.",
+				Optional:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Category the feature is listed under.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func FeatureModelToFeature(model featureResourceModel) *client.Feature {
+	return &client.Feature{
+		Key:         model.Key.ValueString(),
+		DisplayName: model.DisplayName.ValueString(),
+		Description: model.Description.ValueString(),
+		Category:    model.Category.ValueString(),
+	}
+}
+
+func FeatureToFeatureModel(feature client.Feature, model *featureResourceModel) {
+	model.ID = types.StringValue(feature.Key)
+	model.Key = types.StringValue(feature.Key)
+	model.DisplayName = types.StringValue(feature.DisplayName)
+
+	model.Description = types.StringNull()
+	if feature.Description != "" {
+		model.Description = types.StringValue(feature.Description)
+	}
+
+	model.Category = types.StringNull()
+	if feature.Category != "" {
+		model.Category = types.StringValue(feature.Category)
+	}
+
+	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// Create a new resource.
+func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create feature", &resp.Diagnostics) || unconfiguredGuard(r.client, "create feature", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan featureResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new feature
+	rfeature, err := organizationClient(r.client, plan.OrganizationID).CreateFeature(*FeatureModelToFeature(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating feature",
+			"Could not create feature, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	FeatureToFeatureModel(*rfeature, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *featureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
+	// Get current state
+	var state featureResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed feature value from Administration
+	rfeature, err := organizationClient(r.client, state.OrganizationID).GetFeature(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Feature",
+			"Could not read Administration feature "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	FeatureToFeatureModel(*rfeature, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update feature", &resp.Diagnostics) || unconfiguredGuard(r.client, "update feature", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan featureResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing feature
+	rfeature, err := organizationClient(r.client, plan.OrganizationID).UpdateFeature(plan.ID.ValueString(), *FeatureModelToFeature(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Administration Feature",
+			"Could not update feature, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	FeatureToFeatureModel(*rfeature, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *featureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete feature", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete feature", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from state
+	var state featureResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing feature
+	err := organizationClient(r.client, state.OrganizationID).DeleteFeature(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Administration Feature",
+			"Could not delete feature, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *featureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *featureResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &formatMoneyFunction{}
+
+// NewFormatMoneyFunction is a helper function to simplify the provider implementation.
+func NewFormatMoneyFunction() function.Function {
+	return &formatMoneyFunction{}
+}
+
+// formatMoneyFunction is the function implementation.
+type formatMoneyFunction struct{}
+
+// Metadata returns the function name.
+func (f *formatMoneyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_money"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *formatMoneyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Formats an amount of money.",
+		Description: "Rounds amount half away from zero to two decimals, groups thousands with commas and appends the currency, such as 1,234.50 EUR.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:        "amount",
+				Description: "Amount to format.",
+			},
+			function.StringParameter{
+				Name:        "currency",
+				Description: "Currency of the amount.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run formats the amount.
+func (f *formatMoneyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var amount *big.Float
+	var currency string
+	resp.Error = req.Arguments.Get(ctx, &amount, &currency)
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := numberToDecimal(amount)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, formatMoney(value.StringFixed(2), currency))
+}
+
+// formatMoney groups the thousands of a fixed point amount and appends the
+// currency.
+func formatMoney(amount, currency string) string {
+	sign := ""
+	if strings.HasPrefix(amount, "-") {
+		sign, amount = "-", amount[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(amount, ".")
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return sign + grouped.String() + "." + fraction + " " + currency
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type invoiceLineModel struct {
+	Description      types.String  `tfsdk:"description"`
+	PlanID           types.String  `tfsdk:"plan_id"`
+	SubscribeForYear types.Int64   `tfsdk:"subscribe_for_year"`
+	Quantity         types.Int64   `tfsdk:"quantity"`
+	UnitPrice        types.Float64 `tfsdk:"unit_price"`
+	Amount           types.Float64 `tfsdk:"amount"`
+}
+
+type invoiceModel struct {
+	ID             types.String       `tfsdk:"id"`
+	Number         types.String       `tfsdk:"number"`
+	OrganizationID types.String       `tfsdk:"organization_id"`
+	PeriodStart    types.String       `tfsdk:"period_start"`
+	PeriodEnd      types.String       `tfsdk:"period_end"`
+	IssuedAt       types.String       `tfsdk:"issued_at"`
+	Status         types.String       `tfsdk:"status"`
+	Currency       types.String       `tfsdk:"currency"`
+	Subtotal       types.Float64      `tfsdk:"subtotal"`
+	Tax            types.Float64      `tfsdk:"tax"`
+	Total          types.Float64      `tfsdk:"total"`
+	Lines          []invoiceLineModel `tfsdk:"lines"`
+}
+
+type invoicesDataSourceModel struct {
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Status         types.String   `tfsdk:"status"`
+	From           types.String   `tfsdk:"from"`
+	To             types.String   `tfsdk:"to"`
+	Invoices       []invoiceModel `tfsdk:"invoices"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &invoicesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &invoicesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &invoicesDataSource{}
+)
+
+// NewInvoicesDataSource is a helper function to simplify the provider implementation.
+func NewInvoicesDataSource() datasource.DataSource {
+	return &invoicesDataSource{}
+}
+
+// invoicesDataSource is the data source implementation.
+type invoicesDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *invoicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoices"
+}
+
+// Schema defines the schema for the data source.
+func (d *invoicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the invoices of an organization, or of every organization.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization. Invoices of every organization are returned when omitted.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return invoices with this status, such as draft, open, paid or void.",
+				Optional:    true,
+			},
+			"from": schema.StringAttribute{
+				Description: "RFC 3339 timestamp, only return invoices issued at or after it.",
+				Optional:    true,
+			},
+			"to": schema.StringAttribute{
+				Description: "RFC 3339 timestamp, only return invoices issued before it.",
+				Optional:    true,
+			},
+			"invoices": schema.ListNestedAttribute{
+				Description: "List of invoices.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the invoice.",
+							Computed:    true,
+						},
+						"number": schema.StringAttribute{
+							Description: "Number of the invoice.",
+							Computed:    true,
+						},
+						"organization_id": schema.StringAttribute{
+							Description: "Identifier of the invoiced organization.",
+							Computed:    true,
+						},
+						"period_start": schema.StringAttribute{
+							Description: "Start of the invoiced period.",
+							Computed:    true,
+						},
+						"period_end": schema.StringAttribute{
+							Description: "End of the invoiced period.",
+							Computed:    true,
+						},
+						"issued_at": schema.StringAttribute{
+							Description: "Issue date of the invoice.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the invoice.",
+							Computed:    true,
+						},
+						"currency": schema.StringAttribute{
+							Description: "Currency of the invoice.",
+							Computed:    true,
+						},
+						"subtotal": schema.Float64Attribute{
+							Description: "Total before tax.",
+							Computed:    true,
+						},
+						"tax": schema.Float64Attribute{
+							Description: "Tax amount.",
+							Computed:    true,
+						},
+						"total": schema.Float64Attribute{
+							Description: "Total including tax.",
+							Computed:    true,
+						},
+						"lines": schema.ListNestedAttribute{
+							Description: "List of lines of the invoice.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"description": schema.StringAttribute{
+										Description: "Description of the line.",
+										Computed:    true,
+									},
+									"plan_id": schema.StringAttribute{
+										Description: "Numeric identifier of the invoiced plan, if any.",
+										Computed:    true,
+									},
+									"subscribe_for_year": schema.Int64Attribute{
+										Description: "Number of year of subscription of the invoiced pricing, if any.",
+										Computed:    true,
+									},
+									"quantity": schema.Int64Attribute{
+										Description: "Invoiced quantity.",
+										Computed:    true,
+									},
+									"unit_price": schema.Float64Attribute{
+										Description: "Price per unit.",
+										Computed:    true,
+									},
+									"amount": schema.Float64Attribute{
+										Description: "Amount of the line.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that from and to are valid RFC 3339 timestamps.
+func (d *invoicesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config invoicesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimestamp(config.From, path.Root("from"), &resp.Diagnostics)
+	validateTimestamp(config.To, path.Root("to"), &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *invoicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if unconfiguredGuard(d.client, "read invoices", &resp.Diagnostics) {
+		return
+	}
+
+	var state invoicesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invoices, err := d.client.GetInvoices(client.InvoiceFilter{
+		OrganizationID: state.OrganizationID.ValueString(),
+		Status:         state.Status.ValueString(),
+		From:           state.From.ValueString(),
+		To:             state.To.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Invoices",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Invoices = []invoiceModel{}
+	for _, invoice := range invoices {
+		model := invoiceModel{
+			ID:             types.StringValue(strconv.Itoa(invoice.ID)),
+			Number:         types.StringValue(invoice.Number),
+			OrganizationID: types.StringValue(invoice.OrganizationID),
+			PeriodStart:    types.StringValue(invoice.PeriodStart),
+			PeriodEnd:      types.StringValue(invoice.PeriodEnd),
+			IssuedAt:       types.StringValue(invoice.IssuedAt),
+			Status:         types.StringValue(invoice.Status),
+			Currency:       types.StringValue(invoice.Currency),
+			Subtotal:       types.Float64Value(invoice.Subtotal),
+			Tax:            types.Float64Value(invoice.Tax),
+			Total:          types.Float64Value(invoice.Total),
+			Lines:          []invoiceLineModel{},
+		}
+
+		for _, line := range invoice.Lines {
+			lineModel := invoiceLineModel{
+				Description:      types.StringValue(line.Description),
+				PlanID:           types.StringNull(),
+				SubscribeForYear: types.Int64Null(),
+				Quantity:         types.Int64Value(int64(line.Quantity)),
+				UnitPrice:        types.Float64Value(line.UnitPrice),
+				Amount:           types.Float64Value(line.Amount),
+			}
+			if line.PlanID != 0 {
+				lineModel.PlanID = types.StringValue(strconv.Itoa(line.PlanID))
+			}
+			if line.SubscribeForYear != 0 {
+				lineModel.SubscribeForYear = types.Int64Value(int64(line.SubscribeForYear))
+			}
+			model.Lines = append(model.Lines, lineModel)
+		}
+
+		state.Invoices = append(state.Invoices, model)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *invoicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
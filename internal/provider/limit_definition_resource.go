@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type limitDefinitionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	Key              types.String `tfsdk:"key"`
+	Unit             types.String `tfsdk:"unit"`
+	Min              types.Int64  `tfsdk:"min"`
+	Max              types.Int64  `tfsdk:"max"`
+	UnlimitedAllowed types.Bool   `tfsdk:"unlimited_allowed"`
+	Description      types.String `tfsdk:"description"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &limitDefinitionResource{}
+	_ resource.ResourceWithConfigure      = &limitDefinitionResource{}
+	_ resource.ResourceWithImportState    = &limitDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &limitDefinitionResource{}
+)
+
+// NewLimitDefinitionResource is a helper function to simplify the provider implementation.
+func NewLimitDefinitionResource() resource.Resource {
+	return &limitDefinitionResource{}
+}
+
+// limitDefinitionResource is the resource implementation.
+type limitDefinitionResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *limitDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_limit_definition"
+}
+
+// Schema defines the schema for the resource.
+func (r *limitDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the definition of a limit plans can set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the limit definition, same as key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute(),
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the limit, as referenced in the limits of a plan.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Description: "Unit of the limit values, such as channels, GB or hours.",
+				Required:    true,
+			},
+			"min": schema.Int64Attribute{
+				Description: "Minimum value of the limit.",
+				Optional:    true,
+			},
+			"max": schema.Int64Attribute{
+				Description: "Maximum value of the limit.",
+				Optional:    true,
+			},
+			"unlimited_allowed": schema.BoolAttribute{
+				Description: "Whether a value of -1 is accepted to mean unlimited. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the limit.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that min is not greater than max.
+func (r *limitDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var minValue, maxValue types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min"), &minValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max"), &maxValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if minValue.IsNull() || minValue.IsUnknown() || maxValue.IsNull() || maxValue.IsUnknown() {
+		return
+	}
+
+	if minValue.ValueInt64() > maxValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min"),
+			"Invalid Limit Definition Bounds",
+			fmt.Sprintf("The min value (%d) must not be greater than the max value (%d).", minValue.ValueInt64(), maxValue.ValueInt64()),
+		)
+	}
+}
+
+func LimitDefinitionModelToLimitDefinition(model limitDefinitionResourceModel) *client.LimitDefinition {
+	definition := client.LimitDefinition{
+		Key:              model.Key.ValueString(),
+		Unit:             model.Unit.ValueString(),
+		UnlimitedAllowed: model.UnlimitedAllowed.ValueBool(),
+		Description:      model.Description.ValueString(),
+	}
+
+	if !model.Min.IsNull() {
+		minValue := int(model.Min.ValueInt64())
+		definition.Min = &minValue
+	}
+
+	if !model.Max.IsNull() {
+		maxValue := int(model.Max.ValueInt64())
+		definition.Max = &maxValue
+	}
+
+	return &definition
+}
+
+func LimitDefinitionToLimitDefinitionModel(definition client.LimitDefinition, model *limitDefinitionResourceModel) {
+	model.ID = types.StringValue(definition.Key)
+	model.Key = types.StringValue(definition.Key)
+	model.Unit = types.StringValue(definition.Unit)
+	model.UnlimitedAllowed = types.BoolValue(definition.UnlimitedAllowed)
+
+	model.Min = types.Int64Null()
+	if definition.Min != nil {
+		model.Min = types.Int64Value(int64(*definition.Min))
+	}
+
+	model.Max = types.Int64Null()
+	if definition.Max != nil {
+		model.Max = types.Int64Value(int64(*definition.Max))
+	}
+
+	model.Description = types.StringNull()
+	if definition.Description != "" {
+		model.Description = types.StringValue(definition.Description)
+	}
+
+	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// Create a new resource.
+func (r *limitDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create limit definition", &resp.Diagnostics) || unconfiguredGuard(r.client, "create limit definition", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan limitDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new limit definition
+	rdefinition, err := organizationClient(r.client, plan.OrganizationID).CreateLimitDefinition(*LimitDefinitionModelToLimitDefinition(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating limit definition",
+			"Could not create limit definition, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	LimitDefinitionToLimitDefinitionModel(*rdefinition, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *limitDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
+	// Get current state
+	var state limitDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed limit definition value from Administration
+	rdefinition, err := organizationClient(r.client, state.OrganizationID).GetLimitDefinition(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Limit Definition",
+			"Could not read Administration limit definition "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	LimitDefinitionToLimitDefinitionModel(*rdefinition, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *limitDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update limit definition", &resp.Diagnostics) || unconfiguredGuard(r.client, "update limit definition", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan limitDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing limit definition
+	rdefinition, err := organizationClient(r.client, plan.OrganizationID).UpdateLimitDefinition(plan.ID.ValueString(), *LimitDefinitionModelToLimitDefinition(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Administration Limit Definition",
+			"Could not update limit definition, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	LimitDefinitionToLimitDefinitionModel(*rdefinition, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *limitDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete limit definition", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete limit definition", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from state
+	var state limitDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing limit definition
+	err := organizationClient(r.client, state.OrganizationID).DeleteLimitDefinition(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Administration Limit Definition",
+			"Could not delete limit definition, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *limitDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *limitDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type limitOverrideResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.Int64  `tfsdk:"value"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &limitOverrideResource{}
+	_ resource.ResourceWithConfigure      = &limitOverrideResource{}
+	_ resource.ResourceWithImportState    = &limitOverrideResource{}
+	_ resource.ResourceWithValidateConfig = &limitOverrideResource{}
+)
+
+// NewLimitOverrideResource is a helper function to simplify the provider implementation.
+func NewLimitOverrideResource() resource.Resource {
+	return &limitOverrideResource{}
+}
+
+// limitOverrideResource is the resource implementation.
+type limitOverrideResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *limitOverrideResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_limit_override"
+}
+
+// Schema defines the schema for the resource.
+func (r *limitOverrideResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Overrides a plan limit for a single organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the limit override.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization the override applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the overridden limit, matching the name of a limit of the plan.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.Int64Attribute{
+				Description: "Value of the limit for the organization.",
+				Required:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the plan limit applies again. The override never expires when omitted.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that expires_at is a valid RFC 3339 timestamp.
+func (r *limitOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config limitOverrideResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimestamp(config.ExpiresAt, path.Root("expires_at"), &resp.Diagnostics)
+}
+
+func LimitOverrideModelToLimitOverride(model limitOverrideResourceModel) *client.LimitOverride {
+	return &client.LimitOverride{
+		OrganizationID: model.OrganizationID.ValueString(),
+		Name:           model.Name.ValueString(),
+		Value:          int(model.Value.ValueInt64()),
+		ExpiresAt:      model.ExpiresAt.ValueString(),
+	}
+}
+
+func LimitOverrideToLimitOverrideModel(override client.LimitOverride, model *limitOverrideResourceModel) {
+	model.OrganizationID = types.StringValue(override.OrganizationID)
+	model.Name = types.StringValue(override.Name)
+	model.Value = types.Int64Value(int64(override.Value))
+	if override.ExpiresAt != "" {
+		model.ExpiresAt = types.StringValue(override.ExpiresAt)
+	} else {
+		model.ExpiresAt = types.StringNull()
+	}
+
+	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// Create a new resource.
+func (r *limitOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create limit override", &resp.Diagnostics) || unconfiguredGuard(r.client, "create limit override", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan limitOverrideResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newOverride := LimitOverrideModelToLimitOverride(plan)
+
+	// Create new limit override
+	roverride, err := r.client.CreateLimitOverride(*newOverride)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating limit override",
+			"Could not create limit override, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(roverride.ID))
+	LimitOverrideToLimitOverrideModel(*roverride, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *limitOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
+	// Get current state
+	var state limitOverrideResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed limit override value from Administration
+	roverride, err := r.client.GetLimitOverride(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Limit Override",
+			"Could not read Administration limit override ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(roverride.ID))
+	LimitOverrideToLimitOverrideModel(*roverride, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *limitOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update limit override", &resp.Diagnostics) || unconfiguredGuard(r.client, "update limit override", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan limitOverrideResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newOverride := LimitOverrideModelToLimitOverride(plan)
+
+	// Update existing limit override
+	roverride, err := r.client.UpdateLimitOverride(plan.ID.ValueString(), *newOverride)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Administration Limit Override",
+			"Could not update limit override, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	LimitOverrideToLimitOverrideModel(*roverride, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *limitOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete limit override", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete limit override", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from state
+	var state limitOverrideResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing limit override
+	err := r.client.DeleteLimitOverride(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Administration Limit Override",
+			"Could not delete limit override, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *limitOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *limitOverrideResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
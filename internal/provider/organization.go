@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+// organizationIDAttribute returns the attribute overriding the organization
+// the provider acts on behalf of for a single resource.
+func organizationIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Identifier of the organization the resource is managed on behalf of. Defaults to the organization_id of the provider.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// organizationClient returns the client acting on behalf of organizationID,
+// the provider client when it is not set.
+func organizationClient(c *client.Client, organizationID types.String) *client.Client {
+	if organizationID.IsNull() || organizationID.IsUnknown() {
+		return c
+	}
+	return c.ForOrganization(organizationID.ValueString())
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+	"github.com/shopspring/decimal"
+)
+
+// planDiffArgumentModel maps a plan passed to plan_diff. Only the attributes
+// describing the offer are read, any other attribute of the billing plan
+// resource is discarded by Terraform.
+type planDiffArgumentModel struct {
+	Name     types.String       `tfsdk:"name"`
+	Features []types.String     `tfsdk:"features"`
+	Limits   []limitItemModel   `tfsdk:"limits"`
+	Pricing  []pricingItemModel `tfsdk:"pricing"`
+}
+
+type planDiffLimitModel struct {
+	Name  string      `tfsdk:"name"`
+	From  types.Int64 `tfsdk:"from"`
+	To    types.Int64 `tfsdk:"to"`
+	Delta types.Int64 `tfsdk:"delta"`
+}
+
+type planDiffPricingModel struct {
+	SubscribeForYear int64        `tfsdk:"subscribe_for_year"`
+	Currency         string       `tfsdk:"currency"`
+	From             types.Number `tfsdk:"from"`
+	To               types.Number `tfsdk:"to"`
+	Delta            types.Number `tfsdk:"delta"`
+}
+
+type planDiffModel struct {
+	Changed         bool                   `tfsdk:"changed"`
+	FeaturesAdded   []string               `tfsdk:"features_added"`
+	FeaturesRemoved []string               `tfsdk:"features_removed"`
+	Limits          []planDiffLimitModel   `tfsdk:"limits"`
+	Pricing         []planDiffPricingModel `tfsdk:"pricing"`
+}
+
+var planDiffArgumentAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"features": types.ListType{ElemType: types.StringType},
+	"limits": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.Int64Type,
+	}}},
+	"pricing": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"subscribe_for_year":     types.Int64Type,
+		"monthly_price":          types.Float64Type,
+		"monthly_price_currency": types.StringType,
+	}}},
+}
+
+var planDiffReturnAttrTypes = map[string]attr.Type{
+	"changed":          types.BoolType,
+	"features_added":   types.ListType{ElemType: types.StringType},
+	"features_removed": types.ListType{ElemType: types.StringType},
+	"limits": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"from":  types.Int64Type,
+		"to":    types.Int64Type,
+		"delta": types.Int64Type,
+	}}},
+	"pricing": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"subscribe_for_year": types.Int64Type,
+		"currency":           types.StringType,
+		"from":               types.NumberType,
+		"to":                 types.NumberType,
+		"delta":              types.NumberType,
+	}}},
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &planDiffFunction{}
+
+// NewPlanDiffFunction is a helper function to simplify the provider implementation.
+func NewPlanDiffFunction() function.Function {
+	return &planDiffFunction{}
+}
+
+// planDiffFunction is the function implementation.
+type planDiffFunction struct{}
+
+// Metadata returns the function name.
+func (f *planDiffFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "plan_diff"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *planDiffFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Differences between two plans.",
+		Description: "Returns the features added and removed from a to b, the limits whose value changed and the monthly prices that changed per term and currency. " +
+			"from and to are null when a limit or price only exists in one of the plans, and so is delta.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "a",
+				Description:    "Plan to compare from, such as an administration_billing_plan resource.",
+				AttributeTypes: planDiffArgumentAttrTypes,
+			},
+			function.ObjectParameter{
+				Name:           "b",
+				Description:    "Plan to compare to, such as an administration_billing_plan resource.",
+				AttributeTypes: planDiffArgumentAttrTypes,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: planDiffReturnAttrTypes,
+		},
+	}
+}
+
+// Run compares the plans.
+func (f *planDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b planDiffArgumentModel
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+
+	// Compare plans as sent to the API
+	from := PlanModelToPlan(planResourceModel{Name: a.Name, Features: a.Features, Limits: a.Limits, Pricing: a.Pricing})
+	to := PlanModelToPlan(planResourceModel{Name: b.Name, Features: b.Features, Limits: b.Limits, Pricing: b.Pricing})
+
+	resp.Error = resp.Result.Set(ctx, diffPlans(*from, *to))
+}
+
+// diffPlans returns the differences between two plans, in the order the
+// items appear in from then to.
+func diffPlans(from, to client.Plan) planDiffModel {
+	diff := planDiffModel{
+		FeaturesAdded:   []string{},
+		FeaturesRemoved: []string{},
+		Limits:          []planDiffLimitModel{},
+		Pricing:         []planDiffPricingModel{},
+	}
+
+	// Features
+	fromFeatures := map[string]bool{}
+	for _, feature := range from.Features {
+		fromFeatures[feature] = true
+	}
+	toFeatures := map[string]bool{}
+	for _, feature := range to.Features {
+		toFeatures[feature] = true
+		if !fromFeatures[feature] {
+			diff.FeaturesAdded = append(diff.FeaturesAdded, feature)
+		}
+	}
+	for _, feature := range from.Features {
+		if !toFeatures[feature] {
+			diff.FeaturesRemoved = append(diff.FeaturesRemoved, feature)
+		}
+	}
+
+	// Limits
+	toLimits := map[string]int{}
+	for _, limit := range to.Limits {
+		toLimits[limit.Name] = limit.Value
+	}
+	fromLimits := map[string]int{}
+	for _, limit := range from.Limits {
+		fromLimits[limit.Name] = limit.Value
+		toValue, ok := toLimits[limit.Name]
+		switch {
+		case !ok:
+			diff.Limits = append(diff.Limits, planDiffLimitModel{
+				Name:  limit.Name,
+				From:  types.Int64Value(int64(limit.Value)),
+				To:    types.Int64Null(),
+				Delta: types.Int64Null(),
+			})
+		case toValue != limit.Value:
+			diff.Limits = append(diff.Limits, planDiffLimitModel{
+				Name:  limit.Name,
+				From:  types.Int64Value(int64(limit.Value)),
+				To:    types.Int64Value(int64(toValue)),
+				Delta: types.Int64Value(int64(toValue - limit.Value)),
+			})
+		}
+	}
+	for _, limit := range to.Limits {
+		if _, ok := fromLimits[limit.Name]; !ok {
+			diff.Limits = append(diff.Limits, planDiffLimitModel{
+				Name:  limit.Name,
+				From:  types.Int64Null(),
+				To:    types.Int64Value(int64(limit.Value)),
+				Delta: types.Int64Null(),
+			})
+		}
+	}
+
+	// Pricing, per term and currency
+	toPrices := map[string]decimal.Decimal{}
+	for _, pricing := range to.Pricing {
+		toPrices[pricingKey(pricing)] = decimal.NewFromFloat(pricing.MonthlyPrice)
+	}
+	fromPrices := map[string]decimal.Decimal{}
+	for _, pricing := range from.Pricing {
+		fromPrice := decimal.NewFromFloat(pricing.MonthlyPrice)
+		fromPrices[pricingKey(pricing)] = fromPrice
+		toPrice, ok := toPrices[pricingKey(pricing)]
+		switch {
+		case !ok:
+			diff.Pricing = append(diff.Pricing, planDiffPricingModel{
+				SubscribeForYear: int64(pricing.SubscribeForYear),
+				Currency:         pricing.MonthlyPriceCurrency,
+				From:             types.NumberValue(decimalToNumber(fromPrice)),
+				To:               types.NumberNull(),
+				Delta:            types.NumberNull(),
+			})
+		case !toPrice.Equal(fromPrice):
+			diff.Pricing = append(diff.Pricing, planDiffPricingModel{
+				SubscribeForYear: int64(pricing.SubscribeForYear),
+				Currency:         pricing.MonthlyPriceCurrency,
+				From:             types.NumberValue(decimalToNumber(fromPrice)),
+				To:               types.NumberValue(decimalToNumber(toPrice)),
+				Delta:            types.NumberValue(decimalToNumber(toPrice.Sub(fromPrice))),
+			})
+		}
+	}
+	for _, pricing := range to.Pricing {
+		if _, ok := fromPrices[pricingKey(pricing)]; !ok {
+			diff.Pricing = append(diff.Pricing, planDiffPricingModel{
+				SubscribeForYear: int64(pricing.SubscribeForYear),
+				Currency:         pricing.MonthlyPriceCurrency,
+				From:             types.NumberNull(),
+				To:               types.NumberValue(decimalToNumber(decimal.NewFromFloat(pricing.MonthlyPrice))),
+				Delta:            types.NumberNull(),
+			})
+		}
+	}
+
+	diff.Changed = len(diff.FeaturesAdded) > 0 || len(diff.FeaturesRemoved) > 0 || len(diff.Limits) > 0 || len(diff.Pricing) > 0
+	return diff
+}
+
+// pricingKey identifies a pricing item of a plan by term and currency.
+func pricingKey(pricing client.PrincingItem) string {
+	return fmt.Sprintf("%d/%s", pricing.SubscribeForYear, pricing.MonthlyPriceCurrency)
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"terraform-provider-administration/internal/client"
+	"github.com/quortex/terraform-provider-administration/internal/client"
 )
 
 type limitItemModel struct {
@@ -27,12 +27,15 @@ type pricingItemModel struct {
 }
 
 type planResourceModel struct {
-	ID          types.String       `tfsdk:"id"`
-	Name        types.String       `tfsdk:"name"`
-	LastUpdated types.String       `tfsdk:"last_updated"`
-	Features    []types.String     `tfsdk:"features"`
-	Limits      []limitItemModel   `tfsdk:"limits"`
-	Pricing     []pricingItemModel `tfsdk:"pricing"`
+	ID                    types.String       `tfsdk:"id"`
+	OrganizationID        types.String       `tfsdk:"organization_id"`
+	Name                  types.String       `tfsdk:"name"`
+	LastUpdated           types.String       `tfsdk:"last_updated"`
+	Features              []types.String     `tfsdk:"features"`
+	SkipFeatureValidation types.Bool         `tfsdk:"skip_feature_validation"`
+	SkipLimitValidation   types.Bool         `tfsdk:"skip_limit_validation"`
+	Limits                []limitItemModel   `tfsdk:"limits"`
+	Pricing               []pricingItemModel `tfsdk:"pricing"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -40,6 +43,7 @@ var (
 	_ resource.Resource                = &planResource{}
 	_ resource.ResourceWithConfigure   = &planResource{}
 	_ resource.ResourceWithImportState = &planResource{}
+	_ resource.ResourceWithModifyPlan  = &planResource{}
 )
 
 // NewPlanResource is a helper function to simplify the provider implementation.
@@ -69,6 +73,7 @@ func (r *planResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": organizationIDAttribute(),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -77,12 +82,21 @@ func (r *planResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"features": schema.ListAttribute{
-				Description: "List of features of the plan.",
+				Description: "List of features of the plan. Each feature must exist in the feature catalog, " +
+					"reference administration_feature ids so that features created in the same run are checked once they exist.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"skip_feature_validation": schema.BoolAttribute{
+				Description: "Do not check that the features of the plan exist in the feature catalog.",
+				Optional:    true,
+			},
+			"skip_limit_validation": schema.BoolAttribute{
+				Description: "Do not check the limits of the plan against the limit definition catalog.",
+				Optional:    true,
+			},
 			"limits": schema.ListNestedAttribute{
-				Description: "List of limits of the plan.",
+				Description: "List of limits of the plan. Each limit must be declared in the limit definition catalog and respect its bounds, reference administration_limit_definition ids as names so that definitions created in the same run are checked once they exist.",
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -121,6 +135,156 @@ func (r *planResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// ModifyPlan checks the features and limits of the plan against the feature
+// and limit definition catalogs, unless validation is skipped.
+func (r *planResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var organizationID types.String
+	var skipFeatures, skipLimits types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization_id"), &organizationID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("skip_feature_validation"), &skipFeatures)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("skip_limit_validation"), &skipLimits)...)
+	if resp.Diagnostics.HasError() || organizationID.IsUnknown() {
+		return
+	}
+
+	// Catalogs are specific to the organization
+	c := organizationClient(r.client, organizationID)
+
+	if !skipFeatures.ValueBool() {
+		r.validateFeatures(ctx, c, req, resp)
+	}
+
+	if !skipLimits.ValueBool() {
+		r.validateLimits(ctx, c, req, resp)
+	}
+}
+
+// validateFeatures checks that the features of the plan exist in the feature
+// catalog.
+func (r *planResource) validateFeatures(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var features types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("features"), &features)...)
+	if resp.Diagnostics.HasError() || features.IsNull() || features.IsUnknown() {
+		return
+	}
+
+	var keys []types.String
+	resp.Diagnostics.Append(features.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() || len(keys) == 0 {
+		return
+	}
+
+	catalog, err := c.GetFeatures()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Features",
+			"Could not read the feature catalog to check the features of the plan, "+
+				"set skip_feature_validation to bypass this check: "+err.Error(),
+		)
+		return
+	}
+
+	known := map[string]bool{}
+	for _, feature := range catalog {
+		known[feature.Key] = true
+	}
+
+	for i, key := range keys {
+		if key.IsUnknown() || known[key.ValueString()] {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("features").AtListIndex(i),
+			"Unknown Plan Feature",
+			fmt.Sprintf("Feature %q does not exist in the feature catalog. "+
+				"Declare it with an administration_feature resource, or set skip_feature_validation to bypass this check.", key.ValueString()),
+		)
+	}
+}
+
+// validateLimits checks that the limits of the plan are declared in the limit
+// definition catalog and that their values are within the defined bounds.
+func (r *planResource) validateLimits(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var limits types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("limits"), &limits)...)
+	if resp.Diagnostics.HasError() || limits.IsNull() || limits.IsUnknown() {
+		return
+	}
+
+	var items []limitItemModel
+	resp.Diagnostics.Append(limits.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() || len(items) == 0 {
+		return
+	}
+
+	catalog, err := c.GetLimitDefinitions()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Limit Definitions",
+			"Could not read the limit definition catalog to check the limits of the plan, "+
+				"set skip_limit_validation to bypass this check: "+err.Error(),
+		)
+		return
+	}
+
+	definitions := map[string]client.LimitDefinition{}
+	for _, definition := range catalog {
+		definitions[definition.Key] = definition
+	}
+
+	for i, item := range items {
+		if item.Name.IsUnknown() {
+			continue
+		}
+
+		definition, ok := definitions[item.Name.ValueString()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limits").AtListIndex(i).AtName("name"),
+				"Unknown Plan Limit",
+				fmt.Sprintf("Limit %q does not exist in the limit definition catalog. "+
+					"Declare it with an administration_limit_definition resource, or set skip_limit_validation to bypass this check.", item.Name.ValueString()),
+			)
+			continue
+		}
+
+		if item.Value.IsUnknown() {
+			continue
+		}
+
+		if err := checkLimitValue(definition, int(item.Value.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limits").AtListIndex(i).AtName("value"),
+				"Invalid Plan Limit",
+				fmt.Sprintf("Limit %q: %s.", item.Name.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// checkLimitValue returns an error when value does not satisfy the limit
+// definition.
+func checkLimitValue(definition client.LimitDefinition, value int) error {
+	if value == -1 && definition.UnlimitedAllowed {
+		return nil
+	}
+	if definition.Min != nil && value < *definition.Min {
+		return fmt.Errorf("value %d %s is below the minimum of %d", value, definition.Unit, *definition.Min)
+	}
+	if definition.Max != nil && value > *definition.Max {
+		return fmt.Errorf("value %d %s is above the maximum of %d", value, definition.Unit, *definition.Max)
+	}
+	if value < 0 {
+		return fmt.Errorf("value %d %s is negative", value, definition.Unit)
+	}
+	return nil
+}
+
 func PlanModelToPlan(plan planResourceModel) *client.Plan {
 	newPlan := client.Plan{
 		Name: plan.Name.ValueString(),
@@ -182,6 +346,10 @@ func PlanToPlanModel(plan client.Plan, model *planResourceModel) {
 
 // Create a new resource.
 func (r *planResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create plan", &resp.Diagnostics) || unconfiguredGuard(r.client, "create plan", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan planResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -194,7 +362,7 @@ func (r *planResource) Create(ctx context.Context, req resource.CreateRequest, r
 	newPlan := PlanModelToPlan(plan)
 
 	// Create new plan
-	rplan, err := r.client.CreatePlan(*newPlan)
+	rplan, err := organizationClient(r.client, plan.OrganizationID).CreatePlan(*newPlan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating plan",
@@ -217,6 +385,12 @@ func (r *planResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read resource information.
 func (r *planResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
 	// Get current state
 	var state planResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -226,7 +400,7 @@ func (r *planResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get refreshed order value from Administration
-	rplan, err := r.client.GetPlan(state.ID.ValueString())
+	rplan, err := organizationClient(r.client, state.OrganizationID).GetPlan(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Administration Plan",
@@ -248,6 +422,10 @@ func (r *planResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *planResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update plan", &resp.Diagnostics) || unconfiguredGuard(r.client, "update plan", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan planResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -260,7 +438,7 @@ func (r *planResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	newPlan := PlanModelToPlan(plan)
 
 	// Update existing order
-	_, err := r.client.UpdatePlan(plan.ID.ValueString(), *newPlan)
+	_, err := organizationClient(r.client, plan.OrganizationID).UpdatePlan(plan.ID.ValueString(), *newPlan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Administration Plan",
@@ -271,7 +449,7 @@ func (r *planResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Fetch updated items from GetOrder as UpdateOrder items are not
 	// populated.
-	rplan, err := r.client.GetPlan(plan.ID.ValueString())
+	rplan, err := organizationClient(r.client, plan.OrganizationID).GetPlan(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Administration Plan",
@@ -291,6 +469,10 @@ func (r *planResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *planResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete plan", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete plan", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from state
 	var state planResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -300,7 +482,7 @@ func (r *planResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete existing order
-	err := r.client.DeletePlan(state.ID.ValueString())
+	err := organizationClient(r.client, state.OrganizationID).DeletePlan(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Administration Plan",
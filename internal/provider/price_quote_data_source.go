@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+	"github.com/shopspring/decimal"
+)
+
+type priceQuoteDataSourceModel struct {
+	PlanID             types.String  `tfsdk:"plan_id"`
+	SubscribeForYear   types.Int64   `tfsdk:"subscribe_for_year"`
+	Currency           types.String  `tfsdk:"currency"`
+	Quantity           types.Int64   `tfsdk:"quantity"`
+	CouponID           types.String  `tfsdk:"coupon_id"`
+	MonthlyPrice       types.Float64 `tfsdk:"monthly_price"`
+	MonthlyDiscount    types.Float64 `tfsdk:"monthly_discount"`
+	DiscountedMonths   types.Int64   `tfsdk:"discounted_months"`
+	MonthlyValue       types.Float64 `tfsdk:"monthly_value"`
+	AnnualValue        types.Float64 `tfsdk:"annual_value"`
+	TotalContractValue types.Float64 `tfsdk:"total_contract_value"`
+	Source             types.String  `tfsdk:"source"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &priceQuoteDataSource{}
+	_ datasource.DataSourceWithConfigure = &priceQuoteDataSource{}
+)
+
+// NewPriceQuoteDataSource is a helper function to simplify the provider implementation.
+func NewPriceQuoteDataSource() datasource.DataSource {
+	return &priceQuoteDataSource{}
+}
+
+// priceQuoteDataSource is the data source implementation.
+type priceQuoteDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *priceQuoteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_quote"
+}
+
+// Schema defines the schema for the data source.
+func (d *priceQuoteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes a price quote for a plan. The quote is computed by the API when it supports quoting, from the pricing of the plan otherwise.",
+		Attributes: map[string]schema.Attribute{
+			"plan_id": schema.StringAttribute{
+				Description: "Numeric identifier of the quoted plan.",
+				Required:    true,
+			},
+			"subscribe_for_year": schema.Int64Attribute{
+				Description: "Number of year of subscription, matching a pricing of the plan.",
+				Required:    true,
+			},
+			"currency": schema.StringAttribute{
+				Description: "Currency of the quote, matching a pricing of the plan.",
+				Required:    true,
+			},
+			"quantity": schema.Int64Attribute{
+				Description: "Number of subscriptions. Defaults to 1.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"coupon_id": schema.StringAttribute{
+				Description: "Numeric identifier of a coupon to apply.",
+				Optional:    true,
+			},
+			"monthly_price": schema.Float64Attribute{
+				Description: "Monthly price before discount.",
+				Computed:    true,
+			},
+			"monthly_discount": schema.Float64Attribute{
+				Description: "Monthly discount granted by the coupon.",
+				Computed:    true,
+			},
+			"discounted_months": schema.Int64Attribute{
+				Description: "Number of months the coupon applies to.",
+				Computed:    true,
+			},
+			"monthly_value": schema.Float64Attribute{
+				Description: "Monthly price of the first month, discount included.",
+				Computed:    true,
+			},
+			"annual_value": schema.Float64Attribute{
+				Description: "Average yearly value of the contract, discount included.",
+				Computed:    true,
+			},
+			"total_contract_value": schema.Float64Attribute{
+				Description: "Total value of the contract over its whole term, discount included.",
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Where the quote was computed, server or local.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *priceQuoteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if unconfiguredGuard(d.client, "read price quote", &resp.Diagnostics) {
+		return
+	}
+
+	var state priceQuoteDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Quantity.IsNull() {
+		state.Quantity = types.Int64Value(1)
+	}
+
+	planID, err := strconv.Atoi(state.PlanID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plan_id"),
+			"Invalid Plan ID",
+			"Plan identifiers must be numeric, got: "+state.PlanID.ValueString(),
+		)
+		return
+	}
+
+	quoteRequest := client.QuoteRequest{
+		PlanID:           planID,
+		SubscribeForYear: int(state.SubscribeForYear.ValueInt64()),
+		Currency:         state.Currency.ValueString(),
+		Quantity:         int(state.Quantity.ValueInt64()),
+	}
+	if !state.CouponID.IsNull() {
+		quoteRequest.CouponID, err = strconv.Atoi(state.CouponID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("coupon_id"),
+				"Invalid Coupon ID",
+				"Coupon identifiers must be numeric, got: "+state.CouponID.ValueString(),
+			)
+			return
+		}
+	}
+
+	// Prefer server side quoting, fall back to the pricing of the plan
+	source := "server"
+	quote, err := d.client.CreateQuote(quoteRequest)
+	if errors.Is(err, client.ErrQuoteUnavailable) {
+		tflog.Debug(ctx, "Server side quoting unavailable, computing quote from plan pricing")
+		source = "local"
+		quote, err = d.localQuote(state, quoteRequest)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Compute Administration Price Quote",
+			"Could not quote plan ID "+state.PlanID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map quote to model
+	state.MonthlyPrice = types.Float64Value(quote.MonthlyPrice)
+	state.MonthlyDiscount = types.Float64Value(quote.MonthlyDiscount)
+	state.DiscountedMonths = types.Int64Value(int64(quote.DiscountedMonths))
+	state.MonthlyValue = types.Float64Value(decimal.NewFromFloat(quote.MonthlyPrice).Sub(decimal.NewFromFloat(quote.MonthlyDiscount)).InexactFloat64())
+	state.AnnualValue = types.Float64Value(quote.AnnualValue)
+	state.TotalContractValue = types.Float64Value(quote.TotalContractValue)
+	state.Source = types.StringValue(source)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// localQuote computes the quote from the pricing of the plan and the coupon.
+func (d *priceQuoteDataSource) localQuote(state priceQuoteDataSourceModel, quoteRequest client.QuoteRequest) (*client.Quote, error) {
+	plan, err := d.client.GetPlan(state.PlanID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	var coupon *client.Coupon
+	if !state.CouponID.IsNull() {
+		coupon, err = d.client.GetCoupon(state.CouponID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.QuotePlan(*plan, coupon, quoteRequest)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *priceQuoteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
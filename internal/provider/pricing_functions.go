@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shopspring/decimal"
+)
+
+// pricingItemAttrTypes are the attribute types of a pricing item of a plan,
+// as accepted and returned by the pricing functions.
+var pricingItemAttrTypes = map[string]attr.Type{
+	"subscribe_for_year":     types.NumberType,
+	"monthly_price":          types.NumberType,
+	"monthly_price_currency": types.StringType,
+}
+
+// pricingFunctionItem maps a pricing item passed to a function. Numbers are
+// kept as types.Number so that amounts are not rounded through float64.
+type pricingFunctionItem struct {
+	SubscribeForYear     types.Number `tfsdk:"subscribe_for_year"`
+	MonthlyPrice         types.Number `tfsdk:"monthly_price"`
+	MonthlyPriceCurrency types.String `tfsdk:"monthly_price_currency"`
+}
+
+// numberToDecimal converts a Terraform number to a decimal, using the shortest
+// representation that identifies the number.
+func numberToDecimal(number *big.Float) (decimal.Decimal, error) {
+	if number == nil {
+		return decimal.Zero, fmt.Errorf("number is null")
+	}
+	if number.IsInf() {
+		return decimal.Zero, fmt.Errorf("number is infinite")
+	}
+	return decimal.NewFromString(number.Text('g', -1))
+}
+
+// decimalToNumber converts a decimal to a Terraform number.
+func decimalToNumber(value decimal.Decimal) *big.Float {
+	number, _, err := big.ParseFloat(value.String(), 10, 512, big.ToNearestEven)
+	if err != nil {
+		// Decimal strings are always valid floats.
+		panic(err)
+	}
+	return number
+}
+
+// contractTotal returns the total value of a pricing item over its term.
+func contractTotal(item pricingFunctionItem) (decimal.Decimal, error) {
+	monthlyPrice, err := numberToDecimal(item.MonthlyPrice.ValueBigFloat())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("monthly_price: %w", err)
+	}
+
+	years, err := numberToDecimal(item.SubscribeForYear.ValueBigFloat())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("subscribe_for_year: %w", err)
+	}
+
+	return monthlyPrice.Mul(decimal.NewFromInt(12)).Mul(years), nil
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shopspring/decimal"
+)
+
+// testNumber parses a number the way Terraform does.
+func testNumber(t *testing.T, s string) *big.Float {
+	t.Helper()
+	number, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return number
+}
+
+// testPricingItem returns a pricing item object as passed to the functions.
+func testPricingItem(t *testing.T, subscribeForYear, monthlyPrice, currency string) types.Object {
+	t.Helper()
+	return types.ObjectValueMust(pricingItemAttrTypes, map[string]attr.Value{
+		"subscribe_for_year":     types.NumberValue(testNumber(t, subscribeForYear)),
+		"monthly_price":          types.NumberValue(testNumber(t, monthlyPrice)),
+		"monthly_price_currency": types.StringValue(currency),
+	})
+}
+
+// testRunFunction runs a function with the arguments and returns its result.
+func testRunFunction(t *testing.T, f function.Function, result attr.Value, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	req := function.RunRequest{Arguments: function.NewArgumentsData(arguments)}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestNumberDecimalRoundTrip(t *testing.T) {
+	tests := []string{"0", "0.1", "3.6", "-12.34", "1234567.891", "0.000001", "99999999999999999999.99"}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			value, err := numberToDecimal(testNumber(t, tt))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value.String() != tt {
+				t.Errorf("numberToDecimal() = %s, want %s", value, tt)
+			}
+
+			if got := decimalToNumber(value); got.Cmp(testNumber(t, tt)) != 0 {
+				t.Errorf("decimalToNumber() = %s, want %s", got.Text('g', -1), tt)
+			}
+			if back, _ := numberToDecimal(decimalToNumber(value)); !back.Equal(value) {
+				t.Errorf("numberToDecimal(decimalToNumber()) = %s, want %s", back, tt)
+			}
+		})
+	}
+}
+
+func TestNumberToDecimalErrors(t *testing.T) {
+	if _, err := numberToDecimal(nil); err == nil {
+		t.Error("numberToDecimal(nil) succeeded, want an error")
+	}
+	if _, err := numberToDecimal(new(big.Float).SetInf(false)); err == nil {
+		t.Error("numberToDecimal(+Inf) succeeded, want an error")
+	}
+}
+
+func TestContractTotal(t *testing.T) {
+	tests := []struct {
+		subscribeForYear string
+		monthlyPrice     string
+		want             string
+	}{
+		{subscribeForYear: "3", monthlyPrice: "0.1", want: "3.6"},
+		{subscribeForYear: "1", monthlyPrice: "19.99", want: "239.88"},
+		{subscribeForYear: "2", monthlyPrice: "0.07", want: "1.68"},
+		{subscribeForYear: "0", monthlyPrice: "10", want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.monthlyPrice+"x"+tt.subscribeForYear, func(t *testing.T) {
+			result, ferr := testRunFunction(t, NewContractTotalFunction(), types.NumberUnknown(),
+				testPricingItem(t, tt.subscribeForYear, tt.monthlyPrice, "EUR"),
+			)
+			if ferr != nil {
+				t.Fatal(ferr)
+			}
+
+			got, err := numberToDecimal(result.(types.Number).ValueBigFloat())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("contract_total() = %s, want exactly %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     string
+	}{
+		{amount: "1234.5", currency: "EUR", want: "1,234.50 EUR"},
+		{amount: "1234567.891", currency: "USD", want: "1,234,567.89 USD"},
+		{amount: "1000", currency: "EUR", want: "1,000.00 EUR"},
+		{amount: "999.999", currency: "EUR", want: "1,000.00 EUR"},
+		{amount: "999.99", currency: "EUR", want: "999.99 EUR"},
+		{amount: "12", currency: "EUR", want: "12.00 EUR"},
+		{amount: "0", currency: "EUR", want: "0.00 EUR"},
+		{amount: "0.005", currency: "EUR", want: "0.01 EUR"},
+		{amount: "2.345", currency: "EUR", want: "2.35 EUR"},
+		{amount: "-2.345", currency: "EUR", want: "-2.35 EUR"},
+		{amount: "-1234.5", currency: "EUR", want: "-1,234.50 EUR"},
+		{amount: "-999", currency: "GBP", want: "-999.00 GBP"},
+		{amount: "-123456", currency: "GBP", want: "-123,456.00 GBP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			result, ferr := testRunFunction(t, NewFormatMoneyFunction(), types.StringUnknown(),
+				types.NumberValue(testNumber(t, tt.amount)),
+				types.StringValue(tt.currency),
+			)
+			if ferr != nil {
+				t.Fatal(ferr)
+			}
+			if got := result.(types.String).ValueString(); got != tt.want {
+				t.Errorf("format_money(%s, %s) = %q, want %q", tt.amount, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheapestTerm(t *testing.T) {
+	tests := []struct {
+		name     string
+		pricing  []attr.Value
+		currency []string
+		want     types.Object
+		wantErr  string
+	}{
+		{
+			name: "lowest monthly price",
+			pricing: []attr.Value{
+				testPricingItem(t, "1", "12", "EUR"),
+				testPricingItem(t, "3", "10", "EUR"),
+				testPricingItem(t, "2", "11", "EUR"),
+			},
+			currency: []string{"EUR"},
+			want:     testPricingItem(t, "3", "10", "EUR"),
+		},
+		{
+			name: "shortest term wins ties",
+			pricing: []attr.Value{
+				testPricingItem(t, "3", "10.00", "EUR"),
+				testPricingItem(t, "1", "10", "EUR"),
+				testPricingItem(t, "2", "10", "EUR"),
+			},
+			currency: []string{"EUR"},
+			want:     testPricingItem(t, "1", "10", "EUR"),
+		},
+		{
+			name: "other currencies are ignored",
+			pricing: []attr.Value{
+				testPricingItem(t, "1", "5", "USD"),
+				testPricingItem(t, "1", "12", "EUR"),
+				testPricingItem(t, "3", "11", "EUR"),
+				testPricingItem(t, "3", "4", "GBP"),
+			},
+			currency: []string{"EUR"},
+			want:     testPricingItem(t, "3", "11", "EUR"),
+		},
+		{
+			name: "currency of the pricing items",
+			pricing: []attr.Value{
+				testPricingItem(t, "1", "12", "EUR"),
+				testPricingItem(t, "3", "11", "EUR"),
+			},
+			want: testPricingItem(t, "3", "11", "EUR"),
+		},
+		{
+			name: "mixed currencies without currency",
+			pricing: []attr.Value{
+				testPricingItem(t, "1", "12", "EUR"),
+				testPricingItem(t, "1", "5", "USD"),
+			},
+			wantErr: "must share the same currency, got EUR and USD",
+		},
+		{
+			name:    "empty pricing",
+			pricing: []attr.Value{},
+			wantErr: "at least one item",
+		},
+		{
+			name:     "empty pricing in currency",
+			pricing:  []attr.Value{},
+			currency: []string{"EUR"},
+			wantErr:  "at least one item",
+		},
+		{
+			name: "several currencies",
+			pricing: []attr.Value{
+				testPricingItem(t, "1", "12", "EUR"),
+			},
+			currency: []string{"EUR", "USD"},
+			wantErr:  "at most one currency",
+		},
+		{
+			name: "no pricing in currency",
+			pricing: []attr.Value{
+				testPricingItem(t, "1", "5", "USD"),
+			},
+			currency: []string{"EUR"},
+			wantErr:  "at least one item in EUR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Variadic arguments are passed as a tuple
+			currencyTypes := []attr.Type{}
+			currencies := []attr.Value{}
+			for _, currency := range tt.currency {
+				currencyTypes = append(currencyTypes, types.StringType)
+				currencies = append(currencies, types.StringValue(currency))
+			}
+
+			result, ferr := testRunFunction(t, NewCheapestTermFunction(), types.ObjectUnknown(pricingItemAttrTypes),
+				types.ListValueMust(types.ObjectType{AttrTypes: pricingItemAttrTypes}, tt.pricing),
+				types.TupleValueMust(currencyTypes, currencies),
+			)
+
+			if tt.wantErr != "" {
+				if ferr == nil || !strings.Contains(ferr.Error(), tt.wantErr) {
+					t.Fatalf("cheapest_term() error = %v, want %q", ferr, tt.wantErr)
+				}
+				return
+			}
+			if ferr != nil {
+				t.Fatal(ferr)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("cheapest_term() = %s, want %s", result, tt.want)
+			}
+		})
+	}
+}
@@ -2,21 +2,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"terraform-provider-administration/internal/client"
+	"github.com/quortex/terraform-provider-administration/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &administrationProvider{}
+	_ provider.Provider                       = &administrationProvider{}
+	_ provider.ProviderWithFunctions          = &administrationProvider{}
+	_ provider.ProviderWithEphemeralResources = &administrationProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -38,10 +45,27 @@ type administrationProvider struct {
 
 // administrationProviderModel maps provider schema data to a Go type.
 type administrationProviderModel struct {
-	AuthServer   types.String `tfsdk:"auth_server"`
-	Host         types.String `tfsdk:"host"`
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	AuthServer            types.String  `tfsdk:"auth_server"`
+	Host                  types.String  `tfsdk:"host"`
+	ClientId              types.String  `tfsdk:"client_id"`
+	ClientSecret          types.String  `tfsdk:"client_secret"`
+	Scopes                types.List    `tfsdk:"scopes"`
+	Audience              types.String  `tfsdk:"audience"`
+	TokenURL              types.String  `tfsdk:"token_url"`
+	TokenEndpointAuth     types.String  `tfsdk:"token_endpoint_auth_method"`
+	PrivateKey            types.String  `tfsdk:"private_key"`
+	PrivateKeyFile        types.String  `tfsdk:"private_key_file"`
+	PrivateKeyID          types.String  `tfsdk:"private_key_id"`
+	PrivateKeyAlgorithm   types.String  `tfsdk:"private_key_algorithm"`
+	TokenCache            types.Bool    `tfsdk:"token_cache"`
+	TokenCacheDir         types.String  `tfsdk:"token_cache_dir"`
+	Profile               types.String  `tfsdk:"profile"`
+	Environment           types.String  `tfsdk:"environment"`
+	ReadOnly              types.Bool    `tfsdk:"read_only"`
+	OrganizationID        types.String  `tfsdk:"organization_id"`
+	JournalPath           types.String  `tfsdk:"journal_path"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 // Metadata returns the provider type name.
@@ -53,7 +77,7 @@ func (p *administrationProvider) Metadata(_ context.Context, _ provider.Metadata
 // Schema defines the provider-level schema for configuration data.
 func (p *administrationProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Interact with Administration.",
+		Description: "Interact with Administration. Settings are read from the provider configuration first, then from environment variables, then from the profile.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Description: "Host for Administration API. May also be provided via ADMINISTRATION_HOST environment variable.",
@@ -65,13 +89,83 @@ func (p *administrationProvider) Schema(_ context.Context, _ provider.SchemaRequ
 			},
 			"client_id": schema.StringAttribute{
 				Description: "ClientId for Administration API. May also be provided via ADMINISTRATION_CLIENT_ID environment variable.",
-				Required:    true,
+				Optional:    true,
 			},
 			"client_secret": schema.StringAttribute{
 				Description: "ClientSecret for Administration API. May also be provided via ADMINISTRATION_CLIENT_SECRET environment variable.",
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Administration deployment to use, one of production, staging, dev or custom. Sets host and auth_server over those of the profile, and of environment variables when set in the configuration, host and auth_server set at the same level take precedence. custom requires both. May also be provided via ADMINISTRATION_ENVIRONMENT environment variable.",
+				Optional:    true,
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Scopes to request for the access token, the scopes granted to the client by default when omitted. May also be provided as a space separated list via ADMINISTRATION_SCOPES environment variable.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"audience": schema.StringAttribute{
+				Description: "Audience to request for the access token. May also be provided via ADMINISTRATION_AUDIENCE environment variable.",
+				Optional:    true,
+			},
+			"token_url": schema.StringAttribute{
+				Description: "Token endpoint of the auth server, <auth_server>/oauth/token when omitted. May also be provided via ADMINISTRATION_TOKEN_URL environment variable.",
+				Optional:    true,
+			},
+			"token_endpoint_auth_method": schema.StringAttribute{
+				Description: "How client credentials are sent to the token endpoint: json for a JSON body, client_secret_post for a form encoded body, client_secret_basic for HTTP Basic authentication or private_key_jwt for a client assertion signed with the private key instead of a client secret. May also be provided via ADMINISTRATION_TOKEN_ENDPOINT_AUTH_METHOD environment variable. Defaults to private_key_jwt when a private key is set, json otherwise.",
+				Optional:    true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "PEM encoded RSA or P-256 private key signing the client assertions of private_key_jwt. Conflicts with private_key_file. May also be provided via ADMINISTRATION_PRIVATE_KEY environment variable.",
+				Optional:    true,
 				Sensitive:   true,
 			},
+			"private_key_file": schema.StringAttribute{
+				Description: "Path of the PEM encoded private key signing the client assertions of private_key_jwt. Conflicts with private_key. May also be provided via ADMINISTRATION_PRIVATE_KEY_FILE environment variable.",
+				Optional:    true,
+			},
+			"private_key_id": schema.StringAttribute{
+				Description: "Identifier of the private key registered with the auth server, sent as the kid header of client assertions. May also be provided via ADMINISTRATION_PRIVATE_KEY_ID environment variable.",
+				Optional:    true,
+			},
+			"private_key_algorithm": schema.StringAttribute{
+				Description: "Algorithm signing client assertions, RS256 or ES256. May also be provided via ADMINISTRATION_PRIVATE_KEY_ALGORITHM environment variable. Defaults to the algorithm matching the private key.",
+				Optional:    true,
+			},
+			"token_cache": schema.BoolAttribute{
+				Description: "When true, access tokens are cached on disk, encrypted with a key derived from the client secret or private key, and reused by the provider processes of later Terraform commands until shortly before they expire. May also be provided via ADMINISTRATION_TOKEN_CACHE environment variable. Defaults to false.",
+				Optional:    true,
+			},
+			"token_cache_dir": schema.StringAttribute{
+				Description: "Directory of the token cache, a quortex/administration/tokens directory in the user cache directory when omitted. May also be provided via ADMINISTRATION_TOKEN_CACHE_DIR environment variable.",
+				Optional:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the profile of the ~/.config/quortex/credentials file (INI or TOML) to read host, auth_server, client_id, client_secret and private_key_file from. May also be provided via ADMINISTRATION_PROFILE environment variable.",
+				Optional:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization to act on behalf of, sent with every request to the Administration API. Resources may override it with their own organization_id. May also be provided via ADMINISTRATION_ORGANIZATION_ID environment variable.",
+				Optional:    true,
+			},
+			"journal_path": schema.StringAttribute{
+				Description: "Path of a file the provider appends one JSON line to for every change it requests, with secrets redacted. May also be provided via ADMINISTRATION_JOURNAL_PATH environment variable.",
+				Optional:    true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests sent to the Administration API per second, shared by every resource and data source. May also be provided via ADMINISTRATION_REQUESTS_PER_SECOND environment variable. Defaults to 0, no limit.",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests to the Administration API in flight at once, shared by every resource and data source. May also be provided via ADMINISTRATION_MAX_CONCURRENT_REQUESTS environment variable. Defaults to 0, no limit.",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "When true, creating, updating or deleting resources fails before any request is sent to the Administration API. May also be provided via ADMINISTRATION_READ_ONLY environment variable. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -87,55 +181,101 @@ func (p *administrationProvider) Configure(ctx context.Context, req provider.Con
 		return
 	}
 
-	// If practitioner provided a configuration value for any of the
-	// attributes, it must be a known value.
-	if config.AuthServer.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("auth_server"),
-			"Unknown Administration API Auth Server",
-			"The provider cannot create the Administration API client as there is an unknown configuration value for the Administration API auth_server. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the ADMINISTRATION_AUTH_SERVER environment variable.",
+	// Values computed from other resources are only known at apply time. Ask
+	// Terraform to defer the operations relying on the provider when it can,
+	// otherwise leave the client unconfigured so that validation and plans
+	// that don't need the Administration API still work.
+	if !req.Config.Raw.IsFullyKnown() {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Unknown Administration API Configuration",
+			"The provider cannot create the Administration API client as there is an unknown configuration value. "+
+				"Resources keep their prior state and data sources can't be read until the value is known. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
 		)
+		return
 	}
 
-	if config.Host.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("host"),
-			"Unknown Administration API Host",
-			"The provider cannot create the Administration API client as there is an unknown configuration value for the Administration API host. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the ADMINISTRATION_HOST environment variable.",
-		)
+	// Default values to the profile, then to environment variables, and
+	// override with Terraform configuration value if set.
+
+	var auth_server, host, client_id, client_secret, private_key_file string
+
+	profile := os.Getenv("ADMINISTRATION_PROFILE")
+	if !config.Profile.IsNull() {
+		profile = config.Profile.ValueString()
 	}
 
-	if config.ClientId.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_id"),
-			"Unknown Administration API ClientId",
-			"The provider cannot create the Administration API client as there is an unknown configuration value for the Administration API client_id. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the ADMINISTRATION_CLIENT_ID environment variable.",
-		)
+	if profile != "" {
+		settings, err := client.LoadProfile(profile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Load Administration API Profile",
+				"The provider cannot create the Administration API client as the profile could not be read from ~/"+client.CredentialsFile+". "+
+					"Administration Profile Error: "+err.Error(),
+			)
+			return
+		}
+		auth_server = settings.AuthServer
+		host = settings.Host
+		client_id = settings.ClientId
+		client_secret = settings.ClientSecret
+		private_key_file = settings.PrivateKeyFile
 	}
 
-	if config.ClientSecret.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_secret"),
-			"Unknown Administration API ClientSecret",
-			"The provider cannot create the Administration API client as there is an unknown configuration value for the Administration API client_secret. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the ADMINISTRATION_CLIENT_SECRET environment variable.",
-		)
+	// The environment presets host and auth server over the levels below the
+	// one it is set at, host and auth server set at the same level win
+	environment := os.Getenv("ADMINISTRATION_ENVIRONMENT")
+	if !config.Environment.IsNull() {
+		environment = config.Environment.ValueString()
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	var preset *client.Environment
+	if environment != "" && environment != client.EnvironmentCustom {
+		known, ok := client.Environments[environment]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment"),
+				"Invalid Administration API Environment",
+				"The environment value must be one of production, staging, dev or custom, got: "+environment,
+			)
+			return
+		}
+		preset = &known
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	if preset != nil && config.Environment.IsNull() {
+		auth_server = preset.AuthServerURL
+		host = preset.HostURL
+	}
 
-	auth_server := os.Getenv("ADMINISTRATION_AUTH_SERVER")
-	host := os.Getenv("ADMINISTRATION_HOST")
-	client_id := os.Getenv("ADMINISTRATION_CLIENT_ID")
-	client_secret := os.Getenv("ADMINISTRATION_CLIENT_SECRET")
+	if value := os.Getenv("ADMINISTRATION_AUTH_SERVER"); value != "" {
+		auth_server = value
+	}
+
+	if value := os.Getenv("ADMINISTRATION_HOST"); value != "" {
+		host = value
+	}
+
+	if value := os.Getenv("ADMINISTRATION_CLIENT_ID"); value != "" {
+		client_id = value
+	}
+
+	if value := os.Getenv("ADMINISTRATION_CLIENT_SECRET"); value != "" {
+		client_secret = value
+	}
+
+	if preset != nil && !config.Environment.IsNull() {
+		auth_server = preset.AuthServerURL
+		host = preset.HostURL
+	}
 
 	if !config.AuthServer.IsNull() {
 		auth_server = config.AuthServer.ValueString()
@@ -153,15 +293,193 @@ func (p *administrationProvider) Configure(ctx context.Context, req provider.Con
 		client_secret = config.ClientSecret.ValueString()
 	}
 
+	scopes := strings.Fields(os.Getenv("ADMINISTRATION_SCOPES"))
+	if !config.Scopes.IsNull() {
+		scopes = []string{}
+		resp.Diagnostics.Append(config.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+
+	audience := os.Getenv("ADMINISTRATION_AUDIENCE")
+	if !config.Audience.IsNull() {
+		audience = config.Audience.ValueString()
+	}
+
+	token_url := os.Getenv("ADMINISTRATION_TOKEN_URL")
+	if !config.TokenURL.IsNull() {
+		token_url = config.TokenURL.ValueString()
+	}
+
+	token_endpoint_auth_method := os.Getenv("ADMINISTRATION_TOKEN_ENDPOINT_AUTH_METHOD")
+	if !config.TokenEndpointAuth.IsNull() {
+		token_endpoint_auth_method = config.TokenEndpointAuth.ValueString()
+	}
+
+	private_key := os.Getenv("ADMINISTRATION_PRIVATE_KEY")
+	if !config.PrivateKey.IsNull() {
+		private_key = config.PrivateKey.ValueString()
+	}
+
+	if value := os.Getenv("ADMINISTRATION_PRIVATE_KEY_FILE"); value != "" {
+		private_key_file = value
+	}
+
+	if !config.PrivateKeyFile.IsNull() {
+		private_key_file = config.PrivateKeyFile.ValueString()
+	}
+
+	private_key_id := os.Getenv("ADMINISTRATION_PRIVATE_KEY_ID")
+	if !config.PrivateKeyID.IsNull() {
+		private_key_id = config.PrivateKeyID.ValueString()
+	}
+
+	private_key_algorithm := os.Getenv("ADMINISTRATION_PRIVATE_KEY_ALGORITHM")
+	if !config.PrivateKeyAlgorithm.IsNull() {
+		private_key_algorithm = config.PrivateKeyAlgorithm.ValueString()
+	}
+
+	if private_key != "" && private_key_file != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key"),
+			"Conflicting Administration API Private Keys",
+			"The provider cannot create the Administration API client as both private_key and private_key_file are set. "+
+				"Set only one of them, in the configuration, with environment variables or in the profile.",
+		)
+	}
+
+	switch private_key_algorithm {
+	case "", client.SigningAlgorithmRS256, client.SigningAlgorithmES256:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key_algorithm"),
+			"Invalid Administration API Private Key Algorithm",
+			"The private_key_algorithm value must be one of RS256 or ES256, got: "+private_key_algorithm,
+		)
+	}
+
+	// A private key replaces the client secret unless told otherwise
+	if token_endpoint_auth_method == "" && (private_key != "" || private_key_file != "") {
+		token_endpoint_auth_method = client.TokenEndpointAuthPrivateKeyJWT
+	}
+
+	switch token_endpoint_auth_method {
+	case "", client.TokenEndpointAuthJSON, client.TokenEndpointAuthClientSecretPost, client.TokenEndpointAuthClientSecretBasic, client.TokenEndpointAuthPrivateKeyJWT:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_endpoint_auth_method"),
+			"Invalid Administration API Token Endpoint Auth Method",
+			"The token_endpoint_auth_method value must be one of json, client_secret_post, client_secret_basic or private_key_jwt, got: "+token_endpoint_auth_method,
+		)
+	}
+
+	token_cache := false
+	if value := os.Getenv("ADMINISTRATION_TOKEN_CACHE"); value != "" {
+		var err error
+		token_cache, err = strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_cache"),
+				"Invalid Administration API Token Cache",
+				"The ADMINISTRATION_TOKEN_CACHE environment variable must be true or false, got: "+value,
+			)
+		}
+	}
+
+	if !config.TokenCache.IsNull() {
+		token_cache = config.TokenCache.ValueBool()
+	}
+
+	token_cache_dir := os.Getenv("ADMINISTRATION_TOKEN_CACHE_DIR")
+	if !config.TokenCacheDir.IsNull() {
+		token_cache_dir = config.TokenCacheDir.ValueString()
+	}
+
+	read_only := false
+	if value := os.Getenv("ADMINISTRATION_READ_ONLY"); value != "" {
+		var err error
+		read_only, err = strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid Administration API Read Only",
+				"The ADMINISTRATION_READ_ONLY environment variable must be true or false, got: "+value,
+			)
+		}
+	}
+
+	if !config.ReadOnly.IsNull() {
+		read_only = config.ReadOnly.ValueBool()
+	}
+
+	organization_id := os.Getenv("ADMINISTRATION_ORGANIZATION_ID")
+	if !config.OrganizationID.IsNull() {
+		organization_id = config.OrganizationID.ValueString()
+	}
+
+	journal_path := os.Getenv("ADMINISTRATION_JOURNAL_PATH")
+	if !config.JournalPath.IsNull() {
+		journal_path = config.JournalPath.ValueString()
+	}
+
+	requests_per_second := 0.0
+	if value := os.Getenv("ADMINISTRATION_REQUESTS_PER_SECOND"); value != "" {
+		var err error
+		requests_per_second, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Administration API Requests Per Second",
+				"The ADMINISTRATION_REQUESTS_PER_SECOND environment variable must be a number, got: "+value,
+			)
+		}
+	}
+
+	if !config.RequestsPerSecond.IsNull() {
+		requests_per_second = config.RequestsPerSecond.ValueFloat64()
+	}
+
+	max_concurrent_requests := 0
+	if value := os.Getenv("ADMINISTRATION_MAX_CONCURRENT_REQUESTS"); value != "" {
+		var err error
+		max_concurrent_requests, err = strconv.Atoi(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid Administration API Max Concurrent Requests",
+				"The ADMINISTRATION_MAX_CONCURRENT_REQUESTS environment variable must be an integer, got: "+value,
+			)
+		}
+	}
+
+	if !config.MaxConcurrentRequests.IsNull() {
+		max_concurrent_requests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+
+	if requests_per_second < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Administration API Requests Per Second",
+			"The requests_per_second value must not be negative.",
+		)
+	}
+
+	if max_concurrent_requests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Administration API Max Concurrent Requests",
+			"The max_concurrent_requests value must not be negative.",
+		)
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
-	if auth_server == "" {
+	// The auth server is only used to derive the token endpoint
+	if auth_server == "" && token_url == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("auth_server"),
 			"Missing Administration API Auth Server",
 			"The provider cannot create the Administration API client as there is a missing or empty value for the Administration API auth_server. "+
-				"Set the auth_server value in the configuration or use the ADMINISTRATION_AUTH_SERVER environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the auth_server value in the configuration, use the ADMINISTRATION_AUTH_SERVER environment variable, set it in the profile, set a preset environment or set token_url. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 
@@ -170,8 +488,8 @@ func (p *administrationProvider) Configure(ctx context.Context, req provider.Con
 			path.Root("host"),
 			"Missing Administration API Host",
 			"The provider cannot create the Administration API client as there is a missing or empty value for the Administration API host. "+
-				"Set the host value in the configuration or use the ADMINISTRATION_HOST environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the host value in the configuration, use the ADMINISTRATION_HOST environment variable, set it in the profile or set a preset environment. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 
@@ -180,18 +498,28 @@ func (p *administrationProvider) Configure(ctx context.Context, req provider.Con
 			path.Root("client_id"),
 			"Missing Administration API ClientId",
 			"The provider cannot create the Administration API client as there is a missing or empty value for the Administration API client_id. "+
-				"Set the client_id value in the configuration or use the ADMINISTRATION_CLIENT_ID environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the client_id value in the configuration, use the ADMINISTRATION_CLIENT_ID environment variable or set it in the profile. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 
-	if client_secret == "" {
+	if token_endpoint_auth_method == client.TokenEndpointAuthPrivateKeyJWT {
+		if private_key == "" && private_key_file == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Missing Administration API Private Key",
+				"The provider cannot create the Administration API client as there is a missing or empty value for the Administration API private_key, required by private_key_jwt. "+
+					"Set the private_key or private_key_file value in the configuration, use the ADMINISTRATION_PRIVATE_KEY or ADMINISTRATION_PRIVATE_KEY_FILE environment variable or set private_key_file in the profile. "+
+					"If any is already set, ensure the value is not empty.",
+			)
+		}
+	} else if client_secret == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_secret"),
 			"Missing Administration API ClientSecret",
 			"The provider cannot create the Administration API client as there is a missing or empty value for the Administration API client_secret. "+
-				"Set the client_secret value in the configuration or use the ADMINISTRATION_CLIENT_SECRET environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the client_secret value in the configuration, use the ADMINISTRATION_CLIENT_SECRET environment variable or set it in the profile. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 
@@ -199,15 +527,110 @@ func (p *administrationProvider) Configure(ctx context.Context, req provider.Con
 		return
 	}
 
+	hostEnvironment := client.GuessEnvironment(host)
+	authServerEnvironment := client.GuessEnvironment(auth_server)
+	if hostEnvironment != "" && authServerEnvironment != "" && hostEnvironment != authServerEnvironment {
+		resp.Diagnostics.AddWarning(
+			"Mismatched Administration API Environments",
+			"The host "+host+" looks like a "+hostEnvironment+" URL while the auth_server "+auth_server+" looks like a "+authServerEnvironment+" URL. "+
+				"Tokens issued by one environment are usually rejected by the other, check the host and auth_server values.",
+		)
+	}
+
+	ctx = tflog.SetField(ctx, "administration_environment", environment)
 	ctx = tflog.SetField(ctx, "administration_auth_server", auth_server)
 	ctx = tflog.SetField(ctx, "administration_host", host)
 	ctx = tflog.SetField(ctx, "administration_client_id", client_id)
 	ctx = tflog.SetField(ctx, "administration_client_secret", client_secret)
+	ctx = tflog.SetField(ctx, "administration_scopes", scopes)
+	ctx = tflog.SetField(ctx, "administration_audience", audience)
+	ctx = tflog.SetField(ctx, "administration_token_url", token_url)
+	ctx = tflog.SetField(ctx, "administration_token_endpoint_auth_method", token_endpoint_auth_method)
+	ctx = tflog.SetField(ctx, "administration_private_key_file", private_key_file)
+	ctx = tflog.SetField(ctx, "administration_private_key_id", private_key_id)
+	ctx = tflog.SetField(ctx, "administration_private_key_algorithm", private_key_algorithm)
+	ctx = tflog.SetField(ctx, "administration_token_cache", token_cache)
+	ctx = tflog.SetField(ctx, "administration_token_cache_dir", token_cache_dir)
+	ctx = tflog.SetField(ctx, "administration_profile", profile)
+	ctx = tflog.SetField(ctx, "administration_read_only", read_only)
+	ctx = tflog.SetField(ctx, "administration_organization_id", organization_id)
+	ctx = tflog.SetField(ctx, "administration_journal_path", journal_path)
+	ctx = tflog.SetField(ctx, "administration_requests_per_second", requests_per_second)
+	ctx = tflog.SetField(ctx, "administration_max_concurrent_requests", max_concurrent_requests)
 
 	tflog.Debug(ctx, "Creating Administration client")
 
+	var journal *client.Journal
+	if journal_path != "" {
+		journal = client.NewJournal(journal_path)
+	}
+
+	var privateKey *client.PrivateKey
+	if token_endpoint_auth_method == client.TokenEndpointAuthPrivateKeyJWT {
+		attribute := path.Root("private_key")
+		pemData := []byte(private_key)
+		if private_key_file != "" {
+			attribute = path.Root("private_key_file")
+			var err error
+			pemData, err = os.ReadFile(private_key_file)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					attribute,
+					"Unable to Read Administration API Private Key",
+					"The provider cannot create the Administration API client as the private key file could not be read. "+
+						"Administration Private Key Error: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		var err error
+		privateKey, err = client.ParsePrivateKey(pemData, private_key_id, private_key_algorithm)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				attribute,
+				"Invalid Administration API Private Key",
+				"The provider cannot create the Administration API client as the private key could not be parsed, it must be a PEM encoded RSA or P-256 key. "+
+					"Administration Private Key Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var tokenCache *client.TokenCache
+	if token_cache {
+		var err error
+		tokenCache, err = client.NewTokenCache(token_cache_dir)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_cache_dir"),
+				"Unable to Locate Administration API Token Cache",
+				"The provider cannot create the Administration API client as the token cache directory could not be determined, set token_cache_dir. "+
+					"Administration Token Cache Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var rateLimiter *client.RateLimiter
+	if requests_per_second > 0 || max_concurrent_requests > 0 {
+		rateLimiter = client.NewRateLimiter(requests_per_second, max_concurrent_requests)
+	}
+
 	// Create a new Administration client using the configuration values
-	client, err := client.NewClient(&auth_server, &host, &client_id, &client_secret)
+	client, err := client.NewClient(&auth_server, &host, &client_id, &client_secret,
+		client.WithUserAgent(fmt.Sprintf("terraform-provider-administration/%s terraform/%s", p.version, req.TerraformVersion)),
+		client.WithTokenURL(token_url),
+		client.WithTokenEndpointAuthMethod(token_endpoint_auth_method),
+		client.WithPrivateKey(privateKey),
+		client.WithTokenCache(tokenCache),
+		client.WithScopes(scopes),
+		client.WithAudience(audience),
+		client.WithOrganizationID(organization_id),
+		client.WithReadOnly(read_only),
+		client.WithJournal(journal),
+		client.WithRateLimiter(rateLimiter),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Administration API Client",
@@ -218,22 +641,53 @@ func (p *administrationProvider) Configure(ctx context.Context, req provider.Con
 		return
 	}
 
-	// Make the Administration client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Administration client available during DataSource, Resource
+	// and EphemeralResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 
 	tflog.Info(ctx, "Configured Administration client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *administrationProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewEffectiveLimitsDataSource,
+		NewUsageDataSource,
+		NewInvoicesDataSource,
+		NewAuditEventsDataSource,
+		NewCallerIdentityDataSource,
+		NewPriceQuoteDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *administrationProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewPlanResource,
+		NewLimitOverrideResource,
+		NewCouponResource,
+		NewCouponRedemptionResource,
+		NewFeatureResource,
+		NewLimitDefinitionResource,
+		NewWebhookResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *administrationProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *administrationProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewContractTotalFunction,
+		NewCheapestTermFunction,
+		NewFormatMoneyFunction,
+		NewPlanDiffFunction,
 	}
 }
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+// testConfigure configures the provider with the configuration values, the
+// other attributes are null.
+func testConfigure(t *testing.T, values map[string]string) (*client.Client, provider.ConfigureResponse) {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)},
+	}
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, req, &resp)
+
+	c, _ := resp.ResourceData.(*client.Client)
+	return c, resp
+}
+
+func TestConfigureEnvironment(t *testing.T) {
+	staging := client.Environments["staging"]
+
+	tests := []struct {
+		name           string
+		env            map[string]string
+		config         map[string]string
+		wantHost       string
+		wantAuthServer string
+		wantErr        bool
+	}{
+		{
+			name:           "preset",
+			config:         map[string]string{"environment": "staging"},
+			wantHost:       staging.HostURL,
+			wantAuthServer: staging.AuthServerURL,
+		},
+		{
+			name:           "host in the configuration wins",
+			config:         map[string]string{"environment": "staging", "host": "https://api.example.com"},
+			wantHost:       "https://api.example.com",
+			wantAuthServer: staging.AuthServerURL,
+		},
+		{
+			name:           "configuration preset wins over environment variables",
+			env:            map[string]string{"ADMINISTRATION_HOST": "https://api.example.com"},
+			config:         map[string]string{"environment": "staging"},
+			wantHost:       staging.HostURL,
+			wantAuthServer: staging.AuthServerURL,
+		},
+		{
+			name:           "host in environment variables wins over their preset",
+			env:            map[string]string{"ADMINISTRATION_ENVIRONMENT": "staging", "ADMINISTRATION_HOST": "https://api.example.com"},
+			wantHost:       "https://api.example.com",
+			wantAuthServer: staging.AuthServerURL,
+		},
+		{
+			name:    "invalid",
+			config:  map[string]string{"environment": "qa"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"ADMINISTRATION_PROFILE", "ADMINISTRATION_ENVIRONMENT", "ADMINISTRATION_HOST", "ADMINISTRATION_AUTH_SERVER"} {
+				t.Setenv(name, tt.env[name])
+			}
+			config := map[string]string{"client_id": "client", "client_secret": "secret"}
+			for name, value := range tt.config {
+				config[name] = value
+			}
+
+			c, resp := testConfigure(t, config)
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("Configure() succeeded, want an error")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Configure() = %v", resp.Diagnostics)
+			}
+			if c.HostURL != tt.wantHost || c.AuthServerURL != tt.wantAuthServer {
+				t.Errorf("host, auth_server = %s, %s, want %s, %s", c.HostURL, c.AuthServerURL, tt.wantHost, tt.wantAuthServer)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+// readOnlyGuard adds an error and returns true when the provider is
+// configured as read only, before any request is sent.
+func readOnlyGuard(c *client.Client, operation string, diags *diag.Diagnostics) bool {
+	if c == nil || !c.ReadOnly {
+		return false
+	}
+
+	diags.AddError(
+		"Administration Provider Is Read Only",
+		"Cannot "+operation+" as the provider is configured with read_only = true. "+
+			"Remove read_only from the provider configuration to apply changes.",
+	)
+	return true
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+// unconfiguredGuard adds an error and returns true when the provider client
+// was not configured because of unknown configuration values. Otherwise it
+// reports the scopes the access token was not granted, see
+// reportMissingScopes.
+func unconfiguredGuard(c *client.Client, operation string, diags *diag.Diagnostics) bool {
+	if c != nil {
+		reportMissingScopes(c, diags)
+		return false
+	}
+
+	diags.AddError(
+		"Administration Provider Is Not Configured",
+		"Cannot "+operation+" as the provider configuration has unknown values. "+
+			"Either target apply the source of the values first or set them statically in the configuration.",
+	)
+	return true
+}
+
+// reportMissingScopes adds a warning when the access token was granted fewer
+// scopes than requested, once per provider process.
+func reportMissingScopes(c *client.Client, diags *diag.Diagnostics) {
+	// Sign in now so that the granted scopes are known, errors are left to
+	// the operation
+	if _, err := c.Token(); err != nil {
+		return
+	}
+
+	granted, missing := c.UnreportedMissingScopes()
+	if len(missing) == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"Administration API Scopes Not Granted",
+		"The auth server granted the access token the scopes "+granted+", without the requested scopes "+strings.Join(missing, " ")+". "+
+			"Requests that need them will be rejected by the Administration API.",
+	)
+}
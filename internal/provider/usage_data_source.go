@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type usageItemModel struct {
+	Name           types.String  `tfsdk:"name"`
+	Current        types.Int64   `tfsdk:"current"`
+	Peak           types.Int64   `tfsdk:"peak"`
+	Limit          types.Int64   `tfsdk:"limit"`
+	Percentage     types.Float64 `tfsdk:"percentage"`
+	PeakPercentage types.Float64 `tfsdk:"peak_percentage"`
+}
+
+type usageDataSourceModel struct {
+	OrganizationID types.String     `tfsdk:"organization_id"`
+	From           types.String     `tfsdk:"from"`
+	To             types.String     `tfsdk:"to"`
+	Usage          []usageItemModel `tfsdk:"usage"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &usageDataSource{}
+	_ datasource.DataSourceWithConfigure      = &usageDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &usageDataSource{}
+)
+
+// NewUsageDataSource is a helper function to simplify the provider implementation.
+func NewUsageDataSource() datasource.DataSource {
+	return &usageDataSource{}
+}
+
+// usageDataSource is the data source implementation.
+type usageDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *usageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+// Schema defines the schema for the data source.
+func (d *usageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the usage of an organization against its effective limits over a period.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+			},
+			"from": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the start of the period. Defaults to the start of the current billing period.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"to": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the end of the period. Defaults to now.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"usage": schema.ListNestedAttribute{
+				Description: "List of usage per limit.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of limit.",
+							Computed:    true,
+						},
+						"current": schema.Int64Attribute{
+							Description: "Consumption at the end of the period.",
+							Computed:    true,
+						},
+						"peak": schema.Int64Attribute{
+							Description: "Highest consumption over the period.",
+							Computed:    true,
+						},
+						"limit": schema.Int64Attribute{
+							Description: "Effective value of limit, -1 when unlimited.",
+							Computed:    true,
+						},
+						"percentage": schema.Float64Attribute{
+							Description: "Current consumption as a percentage of the effective limit. Null when the limit is unlimited or unknown.",
+							Computed:    true,
+						},
+						"peak_percentage": schema.Float64Attribute{
+							Description: "Peak consumption as a percentage of the effective limit. Null when the limit is unlimited or unknown.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that from and to are valid RFC 3339 timestamps.
+func (d *usageDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config usageDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimestamp(config.From, path.Root("from"), &resp.Diagnostics)
+	validateTimestamp(config.To, path.Root("to"), &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if unconfiguredGuard(d.client, "read usage", &resp.Diagnostics) {
+		return
+	}
+
+	var state usageDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := state.OrganizationID.ValueString()
+	usage, err := d.client.GetUsage(organizationID, state.From.ValueString(), state.To.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Usage",
+			"Could not read usage of organization "+organizationID+": "+err.Error(),
+		)
+		return
+	}
+
+	limits, err := d.client.GetEffectiveLimits(organizationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Administration Effective Limits",
+			"Could not read effective limits of organization "+organizationID+": "+err.Error(),
+		)
+		return
+	}
+
+	effective := map[string]int{}
+	for _, item := range limits.Limits {
+		effective[item.Name] = item.Value
+	}
+
+	// Map response body to model
+	state.From = types.StringValue(usage.From)
+	state.To = types.StringValue(usage.To)
+	state.Usage = []usageItemModel{}
+	for _, item := range usage.Items {
+		model := usageItemModel{
+			Name:           types.StringValue(item.Name),
+			Current:        types.Int64Value(int64(item.Current)),
+			Peak:           types.Int64Value(int64(item.Peak)),
+			Limit:          types.Int64Null(),
+			Percentage:     types.Float64Null(),
+			PeakPercentage: types.Float64Null(),
+		}
+		if limit, ok := effective[item.Name]; ok {
+			model.Limit = types.Int64Value(int64(limit))
+			if limit > 0 {
+				model.Percentage = types.Float64Value(float64(item.Current) * 100 / float64(limit))
+				model.PeakPercentage = types.Float64Value(float64(item.Peak) * 100 / float64(limit))
+			}
+		}
+		state.Usage = append(state.Usage, model)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateTimestamp adds an attribute error when a known value is not an
+// RFC 3339 timestamp.
+func validateTimestamp(value types.String, attr path.Path, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			attr,
+			"Invalid Timestamp",
+			"The "+attr.String()+" value must be an RFC 3339 timestamp such as 2024-12-31T23:59:59Z: "+err.Error(),
+		)
+	}
+}
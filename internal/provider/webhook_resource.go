@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+type webhookResourceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+	URL            types.String            `tfsdk:"url"`
+	EventTypes     []types.String          `tfsdk:"event_types"`
+	Enabled        types.Bool              `tfsdk:"enabled"`
+	Headers        map[string]types.String `tfsdk:"headers"`
+	SecretRotation types.String            `tfsdk:"secret_rotation"`
+	SigningSecret  types.String            `tfsdk:"signing_secret"`
+	LastUpdated    types.String            `tfsdk:"last_updated"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithConfigure   = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
+	_ resource.ResourceWithModifyPlan  = &webhookResource{}
+)
+
+// NewWebhookResource is a helper function to simplify the provider implementation.
+func NewWebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+// webhookResource is the resource implementation.
+type webhookResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a webhook endpoint billing and subscription events are pushed to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the webhook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute(),
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL events are posted to.",
+				Required:    true,
+			},
+			"event_types": schema.ListAttribute{
+				Description: "List of event types the webhook is subscribed to.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether events are delivered to the webhook. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"headers": schema.MapAttribute{
+				Description: "Custom headers sent with each delivery.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"secret_rotation": schema.StringAttribute{
+				Description: "Arbitrary value, changing it rotates the signing secret.",
+				Optional:    true,
+			},
+			"signing_secret": schema.StringAttribute{
+				Description: "Secret the deliveries are signed with.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan marks the signing secret as unknown when a rotation is requested.
+func (r *webhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planRotation, stateRotation types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("secret_rotation"), &planRotation)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("secret_rotation"), &stateRotation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planRotation.Equal(stateRotation) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("signing_secret"), types.StringUnknown())...)
+	}
+}
+
+func WebhookModelToWebhook(model webhookResourceModel) *client.Webhook {
+	webhook := client.Webhook{
+		URL:        model.URL.ValueString(),
+		EventTypes: []string{},
+		Enabled:    model.Enabled.ValueBool(),
+	}
+
+	for _, item := range model.EventTypes {
+		webhook.EventTypes = append(webhook.EventTypes, item.ValueString())
+	}
+
+	if model.Headers != nil {
+		webhook.Headers = map[string]string{}
+		for name, value := range model.Headers {
+			webhook.Headers[name] = value.ValueString()
+		}
+	}
+
+	return &webhook
+}
+
+func WebhookToWebhookModel(webhook client.Webhook, model *webhookResourceModel) {
+	model.ID = types.StringValue(strconv.Itoa(webhook.ID))
+	model.URL = types.StringValue(webhook.URL)
+	model.Enabled = types.BoolValue(webhook.Enabled)
+
+	model.EventTypes = []types.String{}
+	for _, item := range webhook.EventTypes {
+		model.EventTypes = append(model.EventTypes, types.StringValue(item))
+	}
+
+	// Empty maps come back from the API as null, keep the empty map of the
+	// plan or state so that it is not reported as a change
+	headers := model.Headers
+	model.Headers = nil
+	if headers != nil || len(webhook.Headers) > 0 {
+		model.Headers = map[string]types.String{}
+	}
+	for name, value := range webhook.Headers {
+		model.Headers[name] = types.StringValue(value)
+	}
+
+	// The signing secret is only returned on creation and rotation.
+	if webhook.SigningSecret != "" {
+		model.SigningSecret = types.StringValue(webhook.SigningSecret)
+	}
+
+	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// Create a new resource.
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyGuard(r.client, "create webhook", &resp.Diagnostics) || unconfiguredGuard(r.client, "create webhook", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new webhook
+	rwebhook, err := organizationClient(r.client, plan.OrganizationID).CreateWebhook(*WebhookModelToWebhook(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating webhook",
+			"Could not create webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	WebhookToWebhookModel(*rwebhook, &plan)
+	if plan.SigningSecret.IsUnknown() {
+		plan.SigningSecret = types.StringNull()
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the prior state until the provider configuration is known
+	if r.client == nil {
+		return
+	}
+	reportMissingScopes(r.client, &resp.Diagnostics)
+
+	// Get current state
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed webhook value from Administration
+	rwebhook, err := organizationClient(r.client, state.OrganizationID).GetWebhook(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Administration Webhook",
+			"Could not read Administration webhook ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	WebhookToWebhookModel(*rwebhook, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyGuard(r.client, "update webhook", &resp.Diagnostics) || unconfiguredGuard(r.client, "update webhook", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan and state
+	var plan, state webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing webhook
+	rwebhook, err := organizationClient(r.client, plan.OrganizationID).UpdateWebhook(plan.ID.ValueString(), *WebhookModelToWebhook(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Administration Webhook",
+			"Could not update webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Rotate the signing secret when requested
+	if !plan.SecretRotation.Equal(state.SecretRotation) {
+		rwebhook, err = organizationClient(r.client, plan.OrganizationID).RotateWebhookSecret(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Rotating Administration Webhook Secret",
+				"Could not rotate signing secret of webhook ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update resource state with updated items and timestamp
+	WebhookToWebhookModel(*rwebhook, &plan)
+	if plan.SigningSecret.IsUnknown() {
+		plan.SigningSecret = state.SigningSecret
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyGuard(r.client, "delete webhook", &resp.Diagnostics) || unconfiguredGuard(r.client, "delete webhook", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from state
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing webhook
+	err := organizationClient(r.client, state.OrganizationID).DeleteWebhook(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Administration Webhook",
+			"Could not delete webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
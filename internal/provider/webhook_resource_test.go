@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/quortex/terraform-provider-administration/internal/client"
+)
+
+func TestWebhookToWebhookModelHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		prior   map[string]types.String
+		headers map[string]string
+		want    map[string]types.String
+	}{
+		{
+			name: "null headers stay null",
+		},
+		{
+			name:  "empty headers stay empty",
+			prior: map[string]types.String{},
+			want:  map[string]types.String{},
+		},
+		{
+			name:    "headers from the API",
+			headers: map[string]string{"X-Team": "billing"},
+			want:    map[string]types.String{"X-Team": types.StringValue("billing")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := webhookResourceModel{Headers: tt.prior}
+			WebhookToWebhookModel(client.Webhook{URL: "https://example.com/hook", Headers: tt.headers}, &model)
+
+			if (model.Headers == nil) != (tt.want == nil) || len(model.Headers) != len(tt.want) {
+				t.Fatalf("headers = %#v, want %#v", model.Headers, tt.want)
+			}
+			for name, value := range tt.want {
+				if !model.Headers[name].Equal(value) {
+					t.Errorf("headers[%s] = %s, want %s", name, model.Headers[name], value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Package webhook signs and verifies the deliveries of Administration
+// webhooks, for services receiving them.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader - Header carrying the signature of webhook deliveries.
+const SignatureHeader string = "X-Quortex-Signature"
+
+// DefaultTolerance - Maximum age of a webhook delivery accepted by VerifySignature.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrSignatureHeader  = errors.New("malformed webhook signature header")
+	ErrSignatureExpired = errors.New("webhook signature timestamp outside of tolerance")
+	ErrSignatureInvalid = errors.New("webhook signature does not match payload")
+)
+
+// SignPayload - Returns the signature header value of a payload sent at the given time.
+//
+// The header has the form "t=<unix timestamp>,v1=<signature>" where signature
+// is the hex encoded HMAC-SHA256 of "<unix timestamp>.<payload>" keyed with the
+// signing secret of the webhook.
+func SignPayload(secret string, payload []byte, timestamp time.Time) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac(secret, t, payload)))
+}
+
+// VerifySignature - Checks the signature header of a webhook delivery.
+//
+// Several v1 signatures may be present while a secret is being rotated, the
+// delivery is accepted when any of them matches. A zero tolerance disables the
+// timestamp check.
+func VerifySignature(secret string, payload []byte, header string, tolerance time.Duration) error {
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return ErrSignatureHeader
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				return ErrSignatureHeader
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrSignatureHeader
+	}
+
+	if tolerance > 0 {
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrSignatureHeader
+		}
+		age := time.Since(time.Unix(unix, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrSignatureExpired
+		}
+	}
+
+	expected := mac(secret, timestamp, payload)
+	for _, signature := range signatures {
+		if hmac.Equal(signature, expected) {
+			return nil
+		}
+	}
+
+	return ErrSignatureInvalid
+}
+
+func mac(secret, timestamp string, payload []byte) []byte {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(timestamp))
+	m.Write([]byte("."))
+	m.Write(payload)
+	return m.Sum(nil)
+}
@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "whsec_current"
+	payload := []byte(`{"event":"plan.updated","id":"42"}`)
+	now := time.Now()
+	header := SignPayload(secret, payload, now)
+	_, signature, _ := strings.Cut(header, ",v1=")
+	previous := SignPayload("whsec_previous", payload, now)
+	_, previousSignature, _ := strings.Cut(previous, ",v1=")
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name      string
+		secret    string
+		payload   []byte
+		header    string
+		tolerance time.Duration
+		want      error
+	}{
+		{
+			name:      "valid signature",
+			secret:    secret,
+			payload:   payload,
+			header:    header,
+			tolerance: DefaultTolerance,
+		},
+		{
+			name:      "tampered payload",
+			secret:    secret,
+			payload:   []byte(`{"event":"plan.updated","id":"43"}`),
+			header:    header,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureInvalid,
+		},
+		{
+			name:      "wrong secret",
+			secret:    "whsec_other",
+			payload:   payload,
+			header:    header,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureInvalid,
+		},
+		{
+			name:      "expired timestamp",
+			secret:    secret,
+			payload:   payload,
+			header:    SignPayload(secret, payload, now.Add(-time.Hour)),
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureExpired,
+		},
+		{
+			name:      "future timestamp",
+			secret:    secret,
+			payload:   payload,
+			header:    SignPayload(secret, payload, now.Add(time.Hour)),
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureExpired,
+		},
+		{
+			name:    "expired timestamp without tolerance",
+			secret:  secret,
+			payload: payload,
+			header:  SignPayload(secret, payload, now.Add(-time.Hour)),
+		},
+		{
+			name:      "rotation with current signature last",
+			secret:    secret,
+			payload:   payload,
+			header:    "t=" + timestamp + ",v1=" + previousSignature + ",v1=" + signature,
+			tolerance: DefaultTolerance,
+		},
+		{
+			name:      "rotation with current signature first",
+			secret:    secret,
+			payload:   payload,
+			header:    "t=" + timestamp + ", v1=" + signature + ", v1=" + previousSignature,
+			tolerance: DefaultTolerance,
+		},
+		{
+			name:      "rotation without current signature",
+			secret:    secret,
+			payload:   payload,
+			header:    previous,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureInvalid,
+		},
+		{
+			name:      "unknown schemes are ignored",
+			secret:    secret,
+			payload:   payload,
+			header:    header + ",v0=deadbeef",
+			tolerance: DefaultTolerance,
+		},
+		{
+			name:      "empty header",
+			secret:    secret,
+			payload:   payload,
+			header:    "",
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureHeader,
+		},
+		{
+			name:      "missing timestamp",
+			secret:    secret,
+			payload:   payload,
+			header:    "v1=" + signature,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureHeader,
+		},
+		{
+			name:      "missing signature",
+			secret:    secret,
+			payload:   payload,
+			header:    "t=" + timestamp,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureHeader,
+		},
+		{
+			name:      "pair without value",
+			secret:    secret,
+			payload:   payload,
+			header:    "t=" + timestamp + ",v1",
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureHeader,
+		},
+		{
+			name:      "signature not hex encoded",
+			secret:    secret,
+			payload:   payload,
+			header:    "t=" + timestamp + ",v1=not-hex",
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureHeader,
+		},
+		{
+			name:      "timestamp not a number",
+			secret:    secret,
+			payload:   payload,
+			header:    "t=yesterday,v1=" + signature,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureHeader,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(tt.secret, tt.payload, tt.header, tt.tolerance)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("VerifySignature() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignPayload(t *testing.T) {
+	// Reference value computed with:
+	// printf '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	got := SignPayload("secret", []byte("{}"), time.Unix(1700000000, 0))
+	want := "t=1700000000,v1=" + "b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163"
+	if got != want {
+		t.Errorf("SignPayload() = %q, want %q", got, want)
+	}
+}